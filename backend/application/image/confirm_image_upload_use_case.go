@@ -0,0 +1,64 @@
+package image
+
+import (
+	"context"
+
+	"fmt"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	storageDomain "github.com/keito-isurugi/kei-talk/domain/storage"
+	"github.com/keito-isurugi/kei-talk/infra/env"
+)
+
+type ConfirmImageUploadInputDto struct {
+	ObjectKey string
+}
+
+type ConfirmImageUploadUseCase interface {
+	Exec(ctx context.Context, input ConfirmImageUploadInputDto) (*ImageUseCaseDto, error)
+}
+
+type confirmImageUploadUseCase struct {
+	ev          *env.Values
+	storageRepo storageDomain.StorageRepository
+	imageRepo   imageDomain.ImageRepository
+}
+
+func NewConfirmImageUploadUseCase(ev *env.Values, storageRepo storageDomain.StorageRepository, imageRepo imageDomain.ImageRepository) ConfirmImageUploadUseCase {
+	return &confirmImageUploadUseCase{
+		ev:          ev,
+		storageRepo: storageRepo,
+		imageRepo:   imageRepo,
+	}
+}
+
+// Exec verifies the object RequestImageUploadUseCase presigned a PUT for
+// actually landed in storage, then flips its pending Image row to
+// ImageStatusCommitted and records the ETag/size storage reported.
+func (uc *confirmImageUploadUseCase) Exec(ctx context.Context, input ConfirmImageUploadInputDto) (*ImageUseCaseDto, error) {
+	etag, size, ok, err := uc.storageRepo.StatObject(ctx, uc.ev.AwsS3BucketName, input.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("object not found for key: %s", input.ObjectKey)
+	}
+
+	img, found, err := uc.imageRepo.FindImageByPath(ctx, input.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("no pending image found for object key: %s", input.ObjectKey)
+	}
+
+	if err := uc.imageRepo.CommitImageUpload(ctx, img.ID, etag, size); err != nil {
+		return nil, err
+	}
+
+	return &ImageUseCaseDto{
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
+		DisplayFlag: true,
+	}, nil
+}
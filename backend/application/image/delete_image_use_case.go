@@ -1,13 +1,13 @@
 package image
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
 )
 
 type DeleteImageUseCase interface {
-	Exec(c echo.Context, id int) error
+	Exec(ctx context.Context, id int) error
 }
 
 type deleteImageUseCase struct {
@@ -20,8 +20,8 @@ func NewDeleteImageUseCase(imageRepo imageDomain.ImageRepository) DeleteImageUse
 	}
 }
 
-func (uc *deleteImageUseCase) Exec(c echo.Context, id int) error {
-	err := uc.imageRepo.DeleteImage(c.Request().Context(), id)
+func (uc *deleteImageUseCase) Exec(ctx context.Context, id int) error {
+	err := uc.imageRepo.DeleteImage(ctx, id)
 	if err != nil {
 		return err
 	}
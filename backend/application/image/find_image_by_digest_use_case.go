@@ -0,0 +1,41 @@
+package image
+
+import (
+	"context"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+)
+
+// FindImageByDigestUseCase looks up an already-registered Image by its
+// content digest, so callers can skip a redundant upload (dedup).
+type FindImageByDigestUseCase interface {
+	Exec(ctx context.Context, digest string) (*ImageUseCaseDto, bool, error)
+}
+
+type findImageByDigestUseCase struct {
+	imageRepo imageDomain.ImageRepository
+}
+
+func NewFindImageByDigestUseCase(imageRepo imageDomain.ImageRepository) FindImageByDigestUseCase {
+	return &findImageByDigestUseCase{
+		imageRepo: imageRepo,
+	}
+}
+
+func (uc *findImageByDigestUseCase) Exec(ctx context.Context, digest string) (*ImageUseCaseDto, bool, error) {
+	img, found, err := uc.imageRepo.FindImageByDigest(ctx, digest)
+	if err != nil {
+		return nil, false, err
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	return &ImageUseCaseDto{
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
+		DisplayFlag: img.DisplayFlag,
+		CreatedAt:   img.CreatedAt,
+		UpdatedAt:   img.UpdatedAt,
+	}, true, nil
+}
@@ -0,0 +1,82 @@
+package image
+
+import (
+	"context"
+	"time"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+)
+
+// ImageHistoryStepDto is one image in a derivation chain, from the
+// requested image up to its root ancestor.
+type ImageHistoryStepDto struct {
+	ID        int
+	Digest    string
+	ImagePath string
+	Tags      []string
+	CreatedAt time.Time
+	CreatedBy string
+}
+
+// GetImageHistoryUseCase walks an image's ParentID chain to the root,
+// mirroring Docker's TagStore.History walk: it stops on a missing parent or
+// a cycle instead of erroring, and annotates every step's tags from a
+// single reverse image_id -> []tag query to avoid N+1.
+type GetImageHistoryUseCase interface {
+	Exec(ctx context.Context, id int) ([]ImageHistoryStepDto, error)
+}
+
+type getImageHistoryUseCase struct {
+	imageRepo imageDomain.ImageRepository
+}
+
+func NewGetImageHistoryUseCase(imageRepo imageDomain.ImageRepository) GetImageHistoryUseCase {
+	return &getImageHistoryUseCase{
+		imageRepo: imageRepo,
+	}
+}
+
+func (uc *getImageHistoryUseCase) Exec(ctx context.Context, id int) ([]ImageHistoryStepDto, error) {
+	var chain []*imageDomain.Image
+
+	seen := make(map[int]bool)
+	currentID := &id
+	for currentID != nil && !seen[*currentID] {
+		seen[*currentID] = true
+
+		img, found, err := uc.imageRepo.FindImage(ctx, *currentID)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			break
+		}
+
+		chain = append(chain, img)
+		currentID = img.ParentID
+	}
+
+	imageIDs := make([]int, len(chain))
+	for i, img := range chain {
+		imageIDs[i] = img.ID
+	}
+
+	tagNamesByImageID, err := uc.imageRepo.ListTagNamesByImageIDs(ctx, imageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]ImageHistoryStepDto, len(chain))
+	for i, img := range chain {
+		steps[i] = ImageHistoryStepDto{
+			ID:        img.ID,
+			Digest:    img.Digest,
+			ImagePath: img.ImagePath,
+			Tags:      tagNamesByImageID[img.ID],
+			CreatedAt: img.CreatedAt,
+			CreatedBy: img.CreatedBy,
+		}
+	}
+
+	return steps, nil
+}
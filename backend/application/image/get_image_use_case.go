@@ -1,13 +1,13 @@
 package image
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	imageDomain "github.com/keito-isurugi/various-app/domain/image"
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
 )
 
 type GetImageUseCase interface {
-	Exec(c echo.Context, id int) (*ImageUseCaseDto, error)
+	Exec(ctx context.Context, id int) (*ImageUseCaseDto, error)
 }
 
 type getImageUseCase struct {
@@ -20,15 +20,15 @@ func NewGetImageUseCase(imageRepo imageDomain.ImageRepository) GetImageUseCase {
 	}
 }
 
-func (uc *getImageUseCase) Exec(c echo.Context, id int) (*ImageUseCaseDto, error) {
-	img, err := uc.imageRepo.GetImage(c.Request().Context(), id)
+func (uc *getImageUseCase) Exec(ctx context.Context, id int) (*ImageUseCaseDto, error) {
+	img, err := uc.imageRepo.GetImage(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	dto := ImageUseCaseDto{
-		ID: img.ID,
-		ImagePath: img.ImagePath,
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
 		DisplayFlag: img.DisplayFlag,
 	}
 
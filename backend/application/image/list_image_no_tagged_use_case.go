@@ -1,10 +1,11 @@
 package image
 
 import (
-	tagApp "github.com/keito-isurugi/various-app/application/tag"
-	"github.com/labstack/echo/v4"
+	"context"
 
-	imageDomain "github.com/keito-isurugi/various-app/domain/image"
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
 )
 
 type ListImagesNoTaggedInputDto struct {
@@ -12,7 +13,7 @@ type ListImagesNoTaggedInputDto struct {
 }
 
 type ListImagesNoTaggedUseCase interface {
-	Exec(c echo.Context, input ListImagesNoTaggedInputDto) (*[]ImageUseCaseDto, error)
+	Exec(ctx context.Context, input ListImagesNoTaggedInputDto) (*[]ImageUseCaseDto, error)
 }
 
 type listImagesNoTaggedUseCase struct {
@@ -25,11 +26,11 @@ func NewListImagesNoTaggedUseCase(imageRepo imageDomain.ImageRepository) ListIma
 	}
 }
 
-func (uc *listImagesNoTaggedUseCase) Exec(c echo.Context, input ListImagesNoTaggedInputDto) (*[]ImageUseCaseDto, error) {
+func (uc *listImagesNoTaggedUseCase) Exec(ctx context.Context, input ListImagesNoTaggedInputDto) (*[]ImageUseCaseDto, error) {
 	tagIDs := imageDomain.ListImagesNoTaggedTags{
 		TagIDs: input.TagIDs,
 	}
-	images, err := uc.imageRepo.GetUntaggedImagesByTags(c.Request().Context(), &tagIDs)
+	images, err := uc.imageRepo.GetUntaggedImagesByTags(ctx, &tagIDs)
 	if err != nil {
 		return nil, err
 	}
@@ -1,21 +1,49 @@
 package image
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
+	"time"
 
-	tagApp "github.com/keito-isurugi/various-app/application/tag"
-	imageDomain "github.com/keito-isurugi/various-app/domain/image"
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
 )
 
 type ImageUseCaseDto struct {
 	ID          int
 	ImagePath   string
 	DisplayFlag bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 	Tags        []tagApp.TagUseCaseOutputDto
 }
 
+// ListImagesInputDto filters and paginates the image listing. CursorCreatedAt
+// and CursorID together form the keyset cursor: both nil/zero for the first
+// page, then set to the last row's values to fetch the next page.
+type ListImagesInputDto struct {
+	Limit           int
+	CursorCreatedAt *time.Time
+	CursorID        int
+	DisplayFlag     *bool
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	Q               string
+	IncludeDeleted  bool
+}
+
+type ListImagesOutputDto struct {
+	Images []ImageUseCaseDto
+	// NextCursorCreatedAt/NextCursorID identify the last row returned, for
+	// use as the next page's cursor. Nil/zero when the page is empty.
+	NextCursorCreatedAt *time.Time
+	NextCursorID        int
+	// MaxUpdatedAt is the newest UpdatedAt across the page, used by callers
+	// to derive an ETag for conditional re-fetching.
+	MaxUpdatedAt time.Time
+}
+
 type ListImagesUseCase interface {
-	Exec(c echo.Context) (*[]ImageUseCaseDto, error)
+	Exec(ctx context.Context, input ListImagesInputDto) (*ListImagesOutputDto, error)
 }
 
 type listImagesUseCase struct {
@@ -28,13 +56,26 @@ func NewListImagesUseCase(imageRepo imageDomain.ImageRepository) ListImagesUseCa
 	}
 }
 
-func (ltuc *listImagesUseCase) Exec(c echo.Context) (*[]ImageUseCaseDto, error) {
-	images, err := ltuc.imageRepo.ListImages(c.Request().Context())
+func (ltuc *listImagesUseCase) Exec(ctx context.Context, input ListImagesInputDto) (*ListImagesOutputDto, error) {
+	query := imageDomain.ListImagesQuery{
+		Limit:           input.Limit,
+		CursorCreatedAt: input.CursorCreatedAt,
+		CursorID:        input.CursorID,
+		DisplayFlag:     input.DisplayFlag,
+		CreatedAfter:    input.CreatedAfter,
+		CreatedBefore:   input.CreatedBefore,
+		Q:               input.Q,
+		IncludeDeleted:  input.IncludeDeleted,
+	}
+
+	images, err := ltuc.imageRepo.ListImagesPaged(ctx, &query)
 	if err != nil {
 		return nil, err
 	}
 
-	dto := make([]ImageUseCaseDto, len(*images))
+	out := ListImagesOutputDto{
+		Images: make([]ImageUseCaseDto, len(*images)),
+	}
 	for i, img := range *images {
 		// Tags を DTO に変換
 		tagsDto := make([]tagApp.TagUseCaseOutputDto, len(img.Tags))
@@ -46,13 +87,22 @@ func (ltuc *listImagesUseCase) Exec(c echo.Context) (*[]ImageUseCaseDto, error)
 		}
 
 		// 各 Image の情報を DTO に詰める
-		dto[i] = ImageUseCaseDto{
+		out.Images[i] = ImageUseCaseDto{
 			ID:          img.ID,
 			ImagePath:   img.ImagePath,
 			DisplayFlag: img.DisplayFlag,
+			CreatedAt:   img.CreatedAt,
+			UpdatedAt:   img.UpdatedAt,
 			Tags:        tagsDto,
 		}
+
+		createdAt := img.CreatedAt
+		out.NextCursorCreatedAt = &createdAt
+		out.NextCursorID = img.ID
+		if img.UpdatedAt.After(out.MaxUpdatedAt) {
+			out.MaxUpdatedAt = img.UpdatedAt
+		}
 	}
 
-	return &dto, nil
+	return &out, nil
 }
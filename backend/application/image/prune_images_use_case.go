@@ -0,0 +1,87 @@
+package image
+
+import (
+	"context"
+	"fmt"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	storageDomain "github.com/keito-isurugi/kei-talk/domain/storage"
+)
+
+type PruneImagesInputDto struct {
+	All     bool
+	Filters map[string][]string
+	DryRun  bool
+}
+
+// PruneReport mirrors `podman image prune`'s summary: every path actually
+// removed, the total bytes reclaimed, and any per-image failures collected
+// instead of aborting the run. On a DryRun, Deleted lists the candidate
+// paths and nothing is removed.
+type PruneReport struct {
+	Deleted   []string
+	Reclaimed int64
+	Errors    []error
+}
+
+// PruneImagesUseCase garbage-collects images with no remaining tags (or
+// matching Filters), modeled on Podman's ImageEngine.Prune: each candidate's
+// S3 object is deleted before its DB row, and a failure on one candidate
+// doesn't stop the rest from being processed.
+type PruneImagesUseCase interface {
+	Exec(ctx context.Context, input PruneImagesInputDto) (*PruneReport, error)
+}
+
+type pruneImagesUseCase struct {
+	imageRepo   imageDomain.ImageRepository
+	storageRepo storageDomain.StorageRepository
+	bucketName  string
+}
+
+func NewPruneImagesUseCase(imageRepo imageDomain.ImageRepository, storageRepo storageDomain.StorageRepository, bucketName string) PruneImagesUseCase {
+	return &pruneImagesUseCase{
+		imageRepo:   imageRepo,
+		storageRepo: storageRepo,
+		bucketName:  bucketName,
+	}
+}
+
+func (uc *pruneImagesUseCase) Exec(ctx context.Context, input PruneImagesInputDto) (*PruneReport, error) {
+	candidates, err := uc.imageRepo.PruneImages(ctx, &imageDomain.PruneImagesQuery{
+		All:     input.All,
+		Filters: input.Filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PruneReport{}
+	if input.DryRun {
+		for _, img := range candidates {
+			report.Deleted = append(report.Deleted, img.ImagePath)
+		}
+		return report, nil
+	}
+
+	for _, img := range candidates {
+		size, err := uc.storageRepo.StatObjectSize(ctx, uc.bucketName, img.Digest)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("stat object for image %d: %w", img.ID, err))
+		}
+
+		if err := uc.storageRepo.DeleteObject(ctx, uc.bucketName, img.Digest); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("delete s3 object for image %d: %w", img.ID, err))
+			continue
+		}
+
+		if err := uc.imageRepo.DeleteImage(ctx, img.ImagePath); err != nil {
+			report.Errors = append(report.Errors, fmt.Errorf("delete image row %d: %w", img.ID, err))
+			continue
+		}
+
+		report.Deleted = append(report.Deleted, img.ImagePath)
+		report.Reclaimed += size
+	}
+
+	return report, nil
+}
@@ -1,13 +1,18 @@
 package image
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
 )
 
 type RegisterImageUseCase interface {
-	Exec(c echo.Context, path string) (string, error)
+	// Exec registers path as a new Image with the given content digest, used
+	// as the canonical S3 object key. Callers should check
+	// FindImageByDigestUseCase first and skip the upload entirely on a hit.
+	// parentID is non-nil when the upload is declared as derived from an
+	// existing image (see POST /images/:id/derive).
+	Exec(ctx context.Context, digest, path string, parentID *int) (string, error)
 }
 
 type registerImageUseCase struct {
@@ -20,13 +25,16 @@ func NewRegisterImageUseCase(imageRepo imageDomain.ImageRepository) RegisterImag
 	}
 }
 
-func (uc *registerImageUseCase) Exec(c echo.Context, path string) (string, error) {
+func (uc *registerImageUseCase) Exec(ctx context.Context, digest, path string, parentID *int) (string, error) {
 	img := imageDomain.Image{
-		ImagePath: path,
+		ImagePath:   path,
 		DisplayFlag: true,
+		Digest:      digest,
+		ParentID:    parentID,
+		Status:      imageDomain.ImageStatusCommitted,
 	}
 
-	path, err := uc.imageRepo.RegisterImage(c.Request().Context(), &img)
+	path, err := uc.imageRepo.RegisterImage(ctx, &img)
 	if err != nil {
 		return "", err
 	}
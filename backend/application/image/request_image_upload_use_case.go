@@ -0,0 +1,80 @@
+package image
+
+import (
+	"context"
+
+	"net/http"
+
+	"time"
+
+	"github.com/google/uuid"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	storageDomain "github.com/keito-isurugi/kei-talk/domain/storage"
+	"github.com/keito-isurugi/kei-talk/infra/env"
+)
+
+type RequestImageUploadInputDto struct {
+	ContentType string
+}
+
+type RequestImageUploadOutputDto struct {
+	ImageID         int
+	UploadURL       string
+	RequiredHeaders http.Header
+	ObjectKey       string
+}
+
+type RequestImageUploadUseCase interface {
+	Exec(ctx context.Context, input RequestImageUploadInputDto) (*RequestImageUploadOutputDto, error)
+}
+
+type requestImageUploadUseCase struct {
+	ev          *env.Values
+	storageRepo storageDomain.StorageRepository
+	imageRepo   imageDomain.ImageRepository
+}
+
+func NewRequestImageUploadUseCase(ev *env.Values, storageRepo storageDomain.StorageRepository, imageRepo imageDomain.ImageRepository) RequestImageUploadUseCase {
+	return &requestImageUploadUseCase{
+		ev:          ev,
+		storageRepo: storageRepo,
+		imageRepo:   imageRepo,
+	}
+}
+
+// Exec issues a presigned PUT URL and pre-inserts the Image row the upload
+// will belong to in ImageStatusPending, so the caller already has the row's
+// id while bytes are still streaming straight to S3. The row only becomes
+// a real image once ConfirmImageUploadUseCase verifies the object and flips
+// it to ImageStatusCommitted.
+func (uc *requestImageUploadUseCase) Exec(ctx context.Context, input RequestImageUploadInputDto) (*RequestImageUploadOutputDto, error) {
+	objectKey := uuid.New().String()
+
+	ttl := time.Duration(uc.ev.AwsPresignURLTTLSeconds) * time.Second
+	uploadURL, headers, err := uc.storageRepo.PresignPutObject(ctx, uc.ev.AwsS3BucketName, objectKey, input.ContentType, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	// Digest holds objectKey as a placeholder rather than a content hash;
+	// ConfirmImageUpload records the ETag and size but leaves Digest as is.
+	// objectKey is already a fresh uuid, so reusing it here satisfies
+	// Image.Digest's uniqueIndex without a second round trip to storage to
+	// hash content that hasn't finished uploading yet.
+	img := imageDomain.Image{
+		ImagePath: objectKey,
+		Digest:    objectKey,
+		Status:    imageDomain.ImageStatusPending,
+	}
+	if _, err := uc.imageRepo.RegisterImage(ctx, &img); err != nil {
+		return nil, err
+	}
+
+	return &RequestImageUploadOutputDto{
+		ImageID:         img.ID,
+		UploadURL:       uploadURL,
+		RequiredHeaders: headers,
+		ObjectKey:       objectKey,
+	}, nil
+}
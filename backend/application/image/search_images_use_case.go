@@ -0,0 +1,75 @@
+package image
+
+import (
+	"context"
+
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+)
+
+type SearchImagesInputDto struct {
+	TagIDs []int
+	Match  string
+	Limit  int
+	Cursor int
+}
+
+type SearchImagesOutputDto struct {
+	Images     []ImageUseCaseDto
+	TotalCount int64
+	NextCursor int
+}
+
+type SearchImagesUseCase interface {
+	Exec(ctx context.Context, input SearchImagesInputDto) (*SearchImagesOutputDto, error)
+}
+
+type searchImagesUseCase struct {
+	imageRepo imageDomain.ImageRepository
+}
+
+func NewSearchImagesUseCase(imageRepo imageDomain.ImageRepository) SearchImagesUseCase {
+	return &searchImagesUseCase{
+		imageRepo: imageRepo,
+	}
+}
+
+func (uc *searchImagesUseCase) Exec(ctx context.Context, input SearchImagesInputDto) (*SearchImagesOutputDto, error) {
+	query := imageDomain.SearchImagesQuery{
+		TagIDs: input.TagIDs,
+		Match:  input.Match,
+		Limit:  input.Limit,
+		Cursor: input.Cursor,
+	}
+
+	images, totalCount, err := uc.imageRepo.SearchImages(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := make([]ImageUseCaseDto, len(*images))
+	nextCursor := 0
+	for i, img := range *images {
+		tagsDto := make([]tagApp.TagUseCaseOutputDto, len(img.Tags))
+		for j, tag := range img.Tags {
+			tagsDto[j] = tagApp.TagUseCaseOutputDto{
+				ID:   tag.ID,
+				Name: tag.Name,
+			}
+		}
+
+		dto[i] = ImageUseCaseDto{
+			ID:          img.ID,
+			ImagePath:   img.ImagePath,
+			DisplayFlag: img.DisplayFlag,
+			Tags:        tagsDto,
+		}
+		nextCursor = img.ID
+	}
+
+	return &SearchImagesOutputDto{
+		Images:     dto,
+		TotalCount: totalCount,
+		NextCursor: nextCursor,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package image_bundle
+
+import (
+	"context"
+
+	imageBundleDomain "github.com/keito-isurugi/kei-talk/domain/image_bundle"
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+)
+
+type ExportImagesInputDto struct {
+	// ImageIDs and TagNames restrict the export to their union (e.g. a
+	// resolved ?ref=name:tag plus any ?tag=foo filters). Both empty exports
+	// every image.
+	ImageIDs []int
+	TagNames []string
+}
+
+type ExportImagesOutputDto struct {
+	Manifest imageBundleDomain.Manifest
+}
+
+// ExportImagesUseCase builds the manifest.json entries for a bulk export
+// tar; callers stream each entry's blob from storage by Digest themselves.
+type ExportImagesUseCase interface {
+	Exec(ctx context.Context, input ExportImagesInputDto) (*ExportImagesOutputDto, error)
+}
+
+type exportImagesUseCase struct {
+	imageBundleRepo    imageBundleDomain.ImageBundleRepository
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository
+}
+
+func NewExportImagesUseCase(
+	imageBundleRepo imageBundleDomain.ImageBundleRepository,
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository,
+) ExportImagesUseCase {
+	return &exportImagesUseCase{
+		imageBundleRepo:    imageBundleRepo,
+		imageReferenceRepo: imageReferenceRepo,
+	}
+}
+
+func (uc *exportImagesUseCase) Exec(ctx context.Context, input ExportImagesInputDto) (*ExportImagesOutputDto, error) {
+	images, err := uc.imageBundleRepo.Export(ctx, &imageBundleDomain.ExportQuery{
+		ImageIDs: input.ImageIDs,
+		TagNames: input.TagNames,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := make(imageBundleDomain.Manifest, len(*images))
+	for i, img := range *images {
+		refs, err := uc.imageReferenceRepo.ListByImageID(ctx, img.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		refNames := make([]string, len(refs))
+		for j, ref := range refs {
+			refNames[j] = ref.Name + ":" + ref.Tag
+		}
+
+		tagNames := make([]string, len(img.Tags))
+		for j, tag := range img.Tags {
+			tagNames[j] = tag.Name
+		}
+
+		manifest[i] = imageBundleDomain.ManifestEntry{
+			Digest:      img.Digest,
+			References:  refNames,
+			Tags:        tagNames,
+			DisplayFlag: img.DisplayFlag,
+		}
+	}
+
+	return &ExportImagesOutputDto{Manifest: manifest}, nil
+}
@@ -0,0 +1,33 @@
+package image_bundle
+
+import (
+	"context"
+
+	imageBundleDomain "github.com/keito-isurugi/kei-talk/domain/image_bundle"
+)
+
+type ImportImagesInputDto struct {
+	Entries imageBundleDomain.Manifest
+}
+
+// ImportImagesUseCase replays a bulk export tar's manifest.json: callers are
+// expected to have already uploaded each entry's blob to storage (keyed by
+// digest, skipping digests that already exist) before calling Exec, which
+// then upserts the images/tags/image_tags/image_references rows atomically.
+type ImportImagesUseCase interface {
+	Exec(ctx context.Context, input ImportImagesInputDto) error
+}
+
+type importImagesUseCase struct {
+	imageBundleRepo imageBundleDomain.ImageBundleRepository
+}
+
+func NewImportImagesUseCase(imageBundleRepo imageBundleDomain.ImageBundleRepository) ImportImagesUseCase {
+	return &importImagesUseCase{
+		imageBundleRepo: imageBundleRepo,
+	}
+}
+
+func (uc *importImagesUseCase) Exec(ctx context.Context, input ImportImagesInputDto) error {
+	return uc.imageBundleRepo.Import(ctx, input.Entries)
+}
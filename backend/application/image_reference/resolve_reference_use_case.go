@@ -0,0 +1,59 @@
+package image_reference
+
+import (
+	"context"
+
+	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+	"github.com/keito-isurugi/kei-talk/reference"
+)
+
+type ResolveReferenceInputDto struct {
+	Name string
+	Tag  string
+}
+
+type ResolveReferenceUseCase interface {
+	Exec(ctx context.Context, input ResolveReferenceInputDto) (*imageApp.ImageUseCaseDto, error)
+}
+
+type resolveReferenceUseCase struct {
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository
+	imageRepo          imageDomain.ImageRepository
+}
+
+func NewResolveReferenceUseCase(
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository,
+	imageRepo imageDomain.ImageRepository,
+) ResolveReferenceUseCase {
+	return &resolveReferenceUseCase{
+		imageReferenceRepo: imageReferenceRepo,
+		imageRepo:          imageRepo,
+	}
+}
+
+func (uc *resolveReferenceUseCase) Exec(ctx context.Context, input ResolveReferenceInputDto) (*imageApp.ImageUseCaseDto, error) {
+	ref, err := reference.Parse(input.Name, input.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	imgRef, err := uc.imageReferenceRepo.Resolve(ctx, ref.Name, ref.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := uc.imageRepo.GetImage(ctx, imgRef.ImageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &imageApp.ImageUseCaseDto{
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
+		DisplayFlag: img.DisplayFlag,
+		CreatedAt:   img.CreatedAt,
+		UpdatedAt:   img.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package image_reference
+
+import (
+	"context"
+
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+	"github.com/keito-isurugi/kei-talk/reference"
+)
+
+type TagImageInputDto struct {
+	ImageID int
+	Name    string
+	Tag     string
+}
+
+type TagImageUseCase interface {
+	Exec(ctx context.Context, input TagImageInputDto) error
+}
+
+type tagImageUseCase struct {
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository
+}
+
+func NewTagImageUseCase(imageReferenceRepo imageReferenceDomain.ImageReferenceRepository) TagImageUseCase {
+	return &tagImageUseCase{
+		imageReferenceRepo: imageReferenceRepo,
+	}
+}
+
+func (uc *tagImageUseCase) Exec(ctx context.Context, input TagImageInputDto) error {
+	ref, err := reference.Parse(input.Name, input.Tag)
+	if err != nil {
+		return err
+	}
+
+	return uc.imageReferenceRepo.Tag(ctx, input.ImageID, ref.Name, ref.Tag)
+}
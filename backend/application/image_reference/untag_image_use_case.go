@@ -0,0 +1,36 @@
+package image_reference
+
+import (
+	"context"
+
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+	"github.com/keito-isurugi/kei-talk/reference"
+)
+
+type UntagImageInputDto struct {
+	Name string
+	Tag  string
+}
+
+type UntagImageUseCase interface {
+	Exec(ctx context.Context, input UntagImageInputDto) error
+}
+
+type untagImageUseCase struct {
+	imageReferenceRepo imageReferenceDomain.ImageReferenceRepository
+}
+
+func NewUntagImageUseCase(imageReferenceRepo imageReferenceDomain.ImageReferenceRepository) UntagImageUseCase {
+	return &untagImageUseCase{
+		imageReferenceRepo: imageReferenceRepo,
+	}
+}
+
+func (uc *untagImageUseCase) Exec(ctx context.Context, input UntagImageInputDto) error {
+	ref, err := reference.Parse(input.Name, input.Tag)
+	if err != nil {
+		return err
+	}
+
+	return uc.imageReferenceRepo.Untag(ctx, ref.Name, ref.Tag)
+}
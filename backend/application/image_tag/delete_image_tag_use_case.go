@@ -1,13 +1,13 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	imageTagDomain "github.com/keito-isurugi/various-app/domain/image_tag"
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type DeleteImageTagUseCase interface {
-	Exec(c echo.Context, id int) error
+	Exec(ctx context.Context, id int) error
 }
 
 type deleteImageTagUseCase struct {
@@ -20,8 +20,8 @@ func NewDeleteImageTagUseCase(imageTagRepo imageTagDomain.ImageTagRepository) De
 	}
 }
 
-func (uc *deleteImageTagUseCase) Exec(c echo.Context, id int) error {
-	err := uc.imageTagRepo.DeleteImageTag(c.Request().Context(), id)
+func (uc *deleteImageTagUseCase) Exec(ctx context.Context, id int) error {
+	err := uc.imageTagRepo.DeleteImageTag(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,13 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type DeleteImageUseCase interface {
-	Exec(c echo.Context, id int) error
+	Exec(ctx context.Context, id int) error
 }
 
 type deleteImageUseCase struct {
@@ -20,8 +20,8 @@ func NewDeleteImageUseCase(imageTagRepo imageTagDomain.ImageTagRepository) Delet
 	}
 }
 
-func (uc *deleteImageUseCase) Exec(c echo.Context, id int) error {
-	err := uc.imageTagRepo.DeleteImageTag(c.Request().Context(), id)
+func (uc *deleteImageUseCase) Exec(ctx context.Context, id int) error {
+	err := uc.imageTagRepo.DeleteImageTag(ctx, id)
 	if err != nil {
 		return err
 	}
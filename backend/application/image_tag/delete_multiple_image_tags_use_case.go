@@ -1,13 +1,13 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type DeleteMultipleImageTagsUseCase interface {
-	Exec(c echo.Context, input UpdateMultipleImageTagsUseCaseInputDto) error
+	Exec(ctx context.Context, input UpdateMultipleImageTagsUseCaseInputDto) error
 }
 
 type deleteMultipleImageTagsUseCase struct {
@@ -20,13 +20,13 @@ func NewDeleteMultipleImageTagsUseCase(imageTagRepo imageTagDomain.ImageTagRepos
 	}
 }
 
-func (uc *deleteMultipleImageTagsUseCase) Exec(c echo.Context, input UpdateMultipleImageTagsUseCaseInputDto) error {
+func (uc *deleteMultipleImageTagsUseCase) Exec(ctx context.Context, input UpdateMultipleImageTagsUseCaseInputDto) error {
 	data := &imageTagDomain.UpdateMultipleImageTags{
 		ImageIDs: input.ImageIDs,
 		TagIDs:   input.TagIDs,
 	}
 
-	err := uc.imageTagRepo.DeleteMultipleImageTags(c.Request().Context(), data)
+	err := uc.imageTagRepo.DeleteMultipleImageTags(ctx, data)
 	if err != nil {
 		return err
 	}
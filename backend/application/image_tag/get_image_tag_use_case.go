@@ -1,13 +1,13 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type GetImageTagUseCase interface {
-	Exec(c echo.Context, id int) (*ImageTagsUseCaseOutputDto, error)
+	Exec(ctx context.Context, id int) (*ImageTagsUseCaseOutputDto, error)
 }
 
 type getImageTagUseCase struct {
@@ -20,16 +20,16 @@ func NewGetImageTagUseCase(imageTagRepo imageTagDomain.ImageTagRepository) GetIm
 	}
 }
 
-func (uc *getImageTagUseCase) Exec(c echo.Context, id int) (*ImageTagsUseCaseOutputDto, error) {
-	it, err := uc.imageTagRepo.GetImageTag(c.Request().Context(), id)
+func (uc *getImageTagUseCase) Exec(ctx context.Context, id int) (*ImageTagsUseCaseOutputDto, error) {
+	it, err := uc.imageTagRepo.GetImageTag(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	dto := ImageTagsUseCaseOutputDto{
-		ID: it.ID,
+		ID:      it.ID,
 		ImageID: it.ImageID,
-		TagID: it.TagID,
+		TagID:   it.TagID,
 	}
 
 	return &dto, nil
@@ -1,13 +1,13 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type GetImageUseCase interface {
-	Exec(c echo.Context, id int) (*ImageUseCaseDto, error)
+	Exec(ctx context.Context, id int) (*ImageUseCaseDto, error)
 }
 
 type getImageUseCase struct {
@@ -20,15 +20,15 @@ func NewGetImageUseCase(imageTagRepo imageTagDomain.ImageTagRepository) GetImage
 	}
 }
 
-func (uc *getImageUseCase) Exec(c echo.Context, id int) (*ImageUseCaseDto, error) {
-	img, err := uc.imageTagRepo.GetImageTag(c.Request().Context(), id)
+func (uc *getImageUseCase) Exec(ctx context.Context, id int) (*ImageUseCaseDto, error) {
+	img, err := uc.imageTagRepo.GetImageTag(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	dto := ImageUseCaseDto{
-		ID: img.ID,
-		ImagePath: img.ImagePath,
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
 		DisplayFlag: img.DisplayFlag,
 	}
 
@@ -1,19 +1,19 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type ImageTagsUseCaseOutputDto struct {
-	ID int
+	ID      int
 	ImageID int
 	TagID   int
 }
 
 type ListImageTagsUseCase interface {
-	Exec(c echo.Context) (*[]ImageTagsUseCaseOutputDto, error)
+	Exec(ctx context.Context) (*[]ImageTagsUseCaseOutputDto, error)
 }
 
 type listImageTagsUseCase struct {
@@ -26,8 +26,8 @@ func NewListImageTagsUseCase(imageTagRepo imageTagDomain.ImageTagRepository) Lis
 	}
 }
 
-func (ltuc *listImageTagsUseCase) Exec(c echo.Context) (*[]ImageTagsUseCaseOutputDto, error) {
-	imageTags, err := ltuc.imageTagRepo.ListImageTags(c.Request().Context())
+func (ltuc *listImageTagsUseCase) Exec(ctx context.Context) (*[]ImageTagsUseCaseOutputDto, error) {
+	imageTags, err := ltuc.imageTagRepo.ListImageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,9 +35,9 @@ func (ltuc *listImageTagsUseCase) Exec(c echo.Context) (*[]ImageTagsUseCaseOutpu
 	dto := make([]ImageTagsUseCaseOutputDto, len(*imageTags))
 	for i, it := range *imageTags {
 		dto[i] = ImageTagsUseCaseOutputDto{
-			ID: it.ID,
+			ID:      it.ID,
 			ImageID: it.ImageID,
-			TagID: it.TagID,
+			TagID:   it.TagID,
 		}
 	}
 
@@ -1,7 +1,7 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
@@ -13,7 +13,7 @@ type ImageUseCaseDto struct {
 }
 
 type ListImagesUseCase interface {
-	Exec(c echo.Context) (*[]ImageUseCaseDto, error)
+	Exec(ctx context.Context) (*[]ImageUseCaseDto, error)
 }
 
 type listImagesUseCase struct {
@@ -26,8 +26,8 @@ func NewListImagesUseCase(imageTagRepo imageTagDomain.ImageTagRepository) ListIm
 	}
 }
 
-func (ltuc *listImagesUseCase) Exec(c echo.Context) (*[]ImageUseCaseDto, error) {
-	images, err := ltuc.imageTagRepo.ListImageTags(c.Request().Context())
+func (ltuc *listImagesUseCase) Exec(ctx context.Context) (*[]ImageUseCaseDto, error) {
+	images, err := ltuc.imageTagRepo.ListImageTags(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +35,8 @@ func (ltuc *listImagesUseCase) Exec(c echo.Context) (*[]ImageUseCaseDto, error)
 	dto := make([]ImageUseCaseDto, len(*images))
 	for i, img := range *images {
 		dto[i] = ImageUseCaseDto{
-			ID: img.ID,
-			ImagePath: img.ImagePath,
+			ID:          img.ID,
+			ImagePath:   img.ImagePath,
 			DisplayFlag: img.DisplayFlag,
 		}
 	}
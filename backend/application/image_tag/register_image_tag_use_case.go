@@ -1,9 +1,9 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	imageTagDomain "github.com/keito-isurugi/various-app/domain/image_tag"
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
 
 type ImageTagUseCaseInputDto struct {
@@ -12,7 +12,7 @@ type ImageTagUseCaseInputDto struct {
 }
 
 type RegisterImageTagUseCase interface {
-	Exec(c echo.Context, input ImageTagUseCaseInputDto) (int, error)
+	Exec(ctx context.Context, input ImageTagUseCaseInputDto) (int, error)
 }
 
 type registerImageUseCase struct {
@@ -25,13 +25,13 @@ func NewRegisterImageTagUseCase(imageTagRepo imageTagDomain.ImageTagRepository)
 	}
 }
 
-func (uc *registerImageUseCase) Exec(c echo.Context, input ImageTagUseCaseInputDto) (int, error) {
+func (uc *registerImageUseCase) Exec(ctx context.Context, input ImageTagUseCaseInputDto) (int, error) {
 	img := imageTagDomain.ImageTag{
 		ImageID: input.ImageID,
-		TagID: input.TagID,
+		TagID:   input.TagID,
 	}
 
-	id, err := uc.imageTagRepo.RegisterImageTag(c.Request().Context(), &img)
+	id, err := uc.imageTagRepo.RegisterImageTag(ctx, &img)
 	if err != nil {
 		return 0, err
 	}
@@ -1,7 +1,7 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
@@ -13,7 +13,7 @@ type ImageTagUseCaseInputDto struct {
 }
 
 type RegisterImageUseCase interface {
-	Exec(c echo.Context, imageTag ImageTagUseCaseInputDto) (int, error)
+	Exec(ctx context.Context, imageTag ImageTagUseCaseInputDto) (int, error)
 }
 
 type registerImageUseCase struct {
@@ -26,13 +26,13 @@ func NewRegisterImageUseCase(imageTagRepo imageTagDomain.ImageTagRepository) Reg
 	}
 }
 
-func (uc *registerImageUseCase) Exec(c echo.Context, imageTag ImageTagUseCaseInputDto) (int, error) {
+func (uc *registerImageUseCase) Exec(ctx context.Context, imageTag ImageTagUseCaseInputDto) (int, error) {
 	img := imageTagDomain.ImageTag{
 		ImageID: imageTag.ImageID,
-		TagID: imageTag.TagID,
+		TagID:   imageTag.TagID,
 	}
 
-	id, err := uc.imageTagRepo.RegisterImageTag(c.Request().Context(), &img)
+	id, err := uc.imageTagRepo.RegisterImageTag(ctx, &img)
 	if err != nil {
 		return 0, err
 	}
@@ -1,7 +1,7 @@
 package image_tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 )
@@ -12,7 +12,7 @@ type UpdateMultipleImageTagsUseCaseInputDto struct {
 }
 
 type RegisterMultipleImageTagsUseCase interface {
-	Exec(c echo.Context, input UpdateMultipleImageTagsUseCaseInputDto) ([]int, error)
+	Exec(ctx context.Context, input UpdateMultipleImageTagsUseCaseInputDto) ([]int, error)
 }
 
 type registerMultipleImageTagsUseCase struct {
@@ -25,13 +25,13 @@ func NewRegisterMultipleImageTagsUseCase(imageTagRepo imageTagDomain.ImageTagRep
 	}
 }
 
-func (uc *registerMultipleImageTagsUseCase) Exec(c echo.Context, input UpdateMultipleImageTagsUseCaseInputDto) ([]int, error) {
+func (uc *registerMultipleImageTagsUseCase) Exec(ctx context.Context, input UpdateMultipleImageTagsUseCaseInputDto) ([]int, error) {
 	data := &imageTagDomain.UpdateMultipleImageTags{
 		ImageIDs: input.ImageIDs,
 		TagIDs:   input.TagIDs,
 	}
 
-	insertedIDs, err := uc.imageTagRepo.RegisterMultipleImageTags(c.Request().Context(), data)
+	insertedIDs, err := uc.imageTagRepo.RegisterMultipleImageTags(ctx, data)
 	if err != nil {
 		return nil, err
 	}
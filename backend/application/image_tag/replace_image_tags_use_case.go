@@ -0,0 +1,30 @@
+package image_tag
+
+import (
+	"context"
+
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
+)
+
+type ReplaceImageTagsInputDto struct {
+	ImageID int
+	TagIDs  []int
+}
+
+type ReplaceImageTagsUseCase interface {
+	Exec(ctx context.Context, input ReplaceImageTagsInputDto) error
+}
+
+type replaceImageTagsUseCase struct {
+	imageTagRepo imageTagDomain.ImageTagRepository
+}
+
+func NewReplaceImageTagsUseCase(imageTagRepo imageTagDomain.ImageTagRepository) ReplaceImageTagsUseCase {
+	return &replaceImageTagsUseCase{
+		imageTagRepo: imageTagRepo,
+	}
+}
+
+func (uc *replaceImageTagsUseCase) Exec(ctx context.Context, input ReplaceImageTagsInputDto) error {
+	return uc.imageTagRepo.ReplaceImageTags(ctx, input.ImageID, input.TagIDs)
+}
@@ -0,0 +1,58 @@
+package image_tag
+
+import (
+	"context"
+
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
+)
+
+type UntagImageInputDto struct {
+	ImageID int
+	TagName string
+}
+
+// UntagPairResult reports whether the (image, tag) join row was actually
+// removed, so a client can distinguish an idempotent no-op (tag name doesn't
+// exist, or the image was never tagged with it) from an error.
+type UntagPairResult struct {
+	ImageID int
+	TagName string
+	Removed bool
+}
+
+// UntagImageUseCase detaches a tag from an image by name, following Podman's
+// UntagImage: only the image_tags join row matching (imageID, tagName) is
+// removed, the image row and its other tag associations are untouched.
+type UntagImageUseCase interface {
+	Exec(ctx context.Context, input UntagImageInputDto) (*UntagPairResult, error)
+}
+
+type untagImageUseCase struct {
+	imageTagRepo imageTagDomain.ImageTagRepository
+	tagRepo      tagDomain.TagRepository
+}
+
+func NewUntagImageUseCase(imageTagRepo imageTagDomain.ImageTagRepository, tagRepo tagDomain.TagRepository) UntagImageUseCase {
+	return &untagImageUseCase{
+		imageTagRepo: imageTagRepo,
+		tagRepo:      tagRepo,
+	}
+}
+
+func (uc *untagImageUseCase) Exec(ctx context.Context, input UntagImageInputDto) (*UntagPairResult, error) {
+	t, found, err := uc.tagRepo.FindTagByName(ctx, input.TagName)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return &UntagPairResult{ImageID: input.ImageID, TagName: input.TagName, Removed: false}, nil
+	}
+
+	removed, err := uc.imageTagRepo.UntagImage(ctx, input.ImageID, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UntagPairResult{ImageID: input.ImageID, TagName: input.TagName, Removed: removed}, nil
+}
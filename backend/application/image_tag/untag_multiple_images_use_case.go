@@ -0,0 +1,59 @@
+package image_tag
+
+import (
+	"context"
+
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
+)
+
+type UntagMultipleImagesInputDto struct {
+	ImageIDs []int
+	TagNames []string
+}
+
+// UntagMultipleImagesUseCase is the bulk variant of UntagImageUseCase: it
+// resolves every name in TagNames once, then detaches each of ImageIDs from
+// each resolved tag, so a client can untag many images across many tags in
+// one call.
+type UntagMultipleImagesUseCase interface {
+	Exec(ctx context.Context, input UntagMultipleImagesInputDto) ([]UntagPairResult, error)
+}
+
+type untagMultipleImagesUseCase struct {
+	imageTagRepo imageTagDomain.ImageTagRepository
+	tagRepo      tagDomain.TagRepository
+}
+
+func NewUntagMultipleImagesUseCase(imageTagRepo imageTagDomain.ImageTagRepository, tagRepo tagDomain.TagRepository) UntagMultipleImagesUseCase {
+	return &untagMultipleImagesUseCase{
+		imageTagRepo: imageTagRepo,
+		tagRepo:      tagRepo,
+	}
+}
+
+func (uc *untagMultipleImagesUseCase) Exec(ctx context.Context, input UntagMultipleImagesInputDto) ([]UntagPairResult, error) {
+	tagIDsByName, err := uc.tagRepo.FindTagsByNames(ctx, input.TagNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []UntagPairResult
+	for _, imageID := range input.ImageIDs {
+		for _, tagName := range input.TagNames {
+			tagID, found := tagIDsByName[tagName]
+			if !found {
+				results = append(results, UntagPairResult{ImageID: imageID, TagName: tagName, Removed: false})
+				continue
+			}
+
+			removed, err := uc.imageTagRepo.UntagImage(ctx, imageID, tagID)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, UntagPairResult{ImageID: imageID, TagName: tagName, Removed: removed})
+		}
+	}
+
+	return results, nil
+}
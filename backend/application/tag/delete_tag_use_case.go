@@ -1,13 +1,13 @@
 package tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	tagDomain "github.com/keito-isurugi/various-app/domain/tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
 )
 
 type DeleteTagUseCase interface {
-	Exec(c echo.Context, id int) error
+	Exec(ctx context.Context, id int) error
 }
 
 type deleteTagUseCase struct {
@@ -20,8 +20,8 @@ func NewDeleteTagUseCase(tagRepo tagDomain.TagRepository) DeleteTagUseCase {
 	}
 }
 
-func (uc *deleteTagUseCase) Exec(c echo.Context, id int) error {
-	err := uc.tagRepo.DeleteTag(c.Request().Context(), id)
+func (uc *deleteTagUseCase) Exec(ctx context.Context, id int) error {
+	err := uc.tagRepo.DeleteTag(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -1,13 +1,13 @@
 package tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	tagDomain "github.com/keito-isurugi/various-app/domain/tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
 )
 
 type GetTagUseCase interface {
-	Exec(c echo.Context, id int) (*TagUseCaseOutputDto, error)
+	Exec(ctx context.Context, id int) (*TagUseCaseOutputDto, error)
 }
 
 type getTagUseCase struct {
@@ -20,8 +20,8 @@ func NewGetTagUseCase(tagRepo tagDomain.TagRepository) GetTagUseCase {
 	}
 }
 
-func (uc *getTagUseCase) Exec(c echo.Context, id int) (*TagUseCaseOutputDto, error) {
-	tag, err := uc.tagRepo.GetTag(c.Request().Context(), id)
+func (uc *getTagUseCase) Exec(ctx context.Context, id int) (*TagUseCaseOutputDto, error) {
+	tag, err := uc.tagRepo.GetTag(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1,9 +1,9 @@
 package tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	tagDomain "github.com/keito-isurugi/various-app/domain/tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
 )
 
 type TagUseCaseOutputDto struct {
@@ -12,7 +12,7 @@ type TagUseCaseOutputDto struct {
 }
 
 type ListTagsUseCase interface {
-	Exec(c echo.Context) (*[]TagUseCaseOutputDto, error)
+	Exec(ctx context.Context) (*[]TagUseCaseOutputDto, error)
 }
 
 type listTagsUseCase struct {
@@ -25,8 +25,8 @@ func NewListTagsUseCase(tagRepo tagDomain.TagRepository) ListTagsUseCase {
 	}
 }
 
-func (ltuc *listTagsUseCase) Exec(c echo.Context) (*[]TagUseCaseOutputDto, error) {
-	tags, err := ltuc.tagRepo.ListTags(c.Request().Context())
+func (ltuc *listTagsUseCase) Exec(ctx context.Context) (*[]TagUseCaseOutputDto, error) {
+	tags, err := ltuc.tagRepo.ListTags(ctx)
 	if err != nil {
 		return nil, err
 	}
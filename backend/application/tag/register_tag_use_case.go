@@ -1,13 +1,14 @@
 package tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
 	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
+	"github.com/keito-isurugi/kei-talk/reference"
 )
 
 type RegisterTagUseCase interface {
-	Exec(c echo.Context, name string) (int, error)
+	Exec(ctx context.Context, name string) (int, error)
 }
 
 type registerTagUseCase struct {
@@ -20,12 +21,23 @@ func NewRegisterTagUseCase(tagRepo tagDomain.TagRepository) RegisterTagUseCase {
 	}
 }
 
-func (uc *registerTagUseCase) Exec(c echo.Context, name string) (int, error) {
+// Exec requires name in canonical "namespace/name:variant" form (see
+// reference.ParseTag) and stores its parsed components alongside the raw
+// string, so callers can later query by namespace prefix or variant.
+func (uc *registerTagUseCase) Exec(ctx context.Context, name string) (int, error) {
+	ref, err := reference.ParseTag(name)
+	if err != nil {
+		return 0, err
+	}
+
 	tag := tagDomain.Tag{
-		Name: name,
+		Name:      ref.String(),
+		Namespace: ref.Namespace,
+		LocalName: ref.Name,
+		Variant:   ref.Variant,
 	}
 
-	id, err := uc.tagRepo.RegisterTag(c.Request().Context(), &tag)
+	id, err := uc.tagRepo.RegisterTag(ctx, &tag)
 	if err != nil {
 		return 0, err
 	}
@@ -1,13 +1,14 @@
 package tag
 
 import (
-	"github.com/labstack/echo/v4"
+	"context"
 
-	tagDomain "github.com/keito-isurugi/various-app/domain/tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
+	"github.com/keito-isurugi/kei-talk/reference"
 )
 
 type UpdateTagUseCase interface {
-	Exec(c echo.Context, input TagUseCaseInputDto) error
+	Exec(ctx context.Context, input TagUseCaseInputDto) error
 }
 
 type updateTagUseCase struct {
@@ -25,16 +26,22 @@ func NewUpdateTagUseCase(tagRepo tagDomain.TagRepository) UpdateTagUseCase {
 	}
 }
 
-func (uc *updateTagUseCase) Exec(c echo.Context, input TagUseCaseInputDto) error {
-	tag := tagDomain.Tag{
-		ID: input.ID,
-		Name: input.Name,
-	}
-
-	err := uc.tagRepo.UpdateTag(c.Request().Context(), &tag)
+// Exec requires input.Name in canonical "namespace/name:variant" form
+// (see reference.ParseTag) and stores its parsed components alongside the
+// raw string, so callers can later query by namespace prefix or variant.
+func (uc *updateTagUseCase) Exec(ctx context.Context, input TagUseCaseInputDto) error {
+	ref, err := reference.ParseTag(input.Name)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	tag := tagDomain.Tag{
+		ID:        input.ID,
+		Name:      ref.String(),
+		Namespace: ref.Namespace,
+		LocalName: ref.Name,
+		Variant:   ref.Variant,
+	}
+
+	return uc.tagRepo.UpdateTag(ctx, &tag)
 }
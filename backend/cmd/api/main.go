@@ -2,12 +2,13 @@ package main
 
 import (
 	"fmt"
+	"net"
 
-	"github.com/keito-isurugi/various-app/infrastructure/aws"
-	"github.com/keito-isurugi/various-app/infrastructure/postgresql"
-	"github.com/keito-isurugi/various-app/infrastructure/env"
-	"github.com/keito-isurugi/various-app/infrastructure/logger"
-	"github.com/keito-isurugi/various-app/server"
+	"github.com/keito-isurugi/kei-talk/infrastructure/aws"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
+	"github.com/keito-isurugi/kei-talk/infrastructure/logger"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+	"github.com/keito-isurugi/kei-talk/server"
 )
 
 func main() {
@@ -31,11 +32,24 @@ func main() {
 	}
 
 	// awsクライアント初期化
-	awsClient, err := aws.NewS3Client(ev)
+	awsClient, err := aws.NewS3Client(ev, zapLogger)
 	if err != nil {
 		zapLogger.Error(err.Error())
 	}
 
+	// gRPCサーバー起動
+	grpcListener, err := net.Listen("tcp", ":"+ev.GrpcServerPort)
+	if err != nil {
+		zapLogger.Error(err.Error())
+	} else {
+		grpcSrv := server.SetupGRPCServer(dbClient)
+		go func() {
+			if err := grpcSrv.Serve(grpcListener); err != nil {
+				zapLogger.Error(err.Error())
+			}
+		}()
+	}
+
 	router := server.SetupRouter(ev, dbClient, zapLogger, awsClient)
-	router.Start(":8080")
+	router.Start(":" + ev.BeServerPort)
 }
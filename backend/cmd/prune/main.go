@@ -0,0 +1,99 @@
+// Command prune runs PruneImagesUseCase once and exits, so it can be wired
+// up as a cron-triggered job (e.g. a k8s CronJob or a plain crontab entry)
+// rather than running as a long-lived server.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	"github.com/keito-isurugi/kei-talk/infrastructure/aws"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
+	"github.com/keito-isurugi/kei-talk/infrastructure/logger"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql/repository"
+	s3Repo "github.com/keito-isurugi/kei-talk/infrastructure/s3"
+)
+
+func main() {
+	all := flag.Bool("all", false, "consider every image, tagged or not, as a prune candidate (narrowed only by -filter)")
+	dryRun := flag.Bool("dry-run", false, "report what would be deleted without deleting anything")
+	var rawFilters filterFlags
+	flag.Var(&rawFilters, "filter", "a key=value filter, repeatable (e.g. -filter before=2026-01-01T00:00:00Z)")
+	flag.Parse()
+
+	ev, err := env.NewValue()
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+
+	zapLogger, err := logger.NewLogger(ev.Debug)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	defer func() { _ = zapLogger.Sync() }()
+
+	dbClient, err := db.NewClient(&ev.DB, zapLogger)
+	if err != nil {
+		zapLogger.Error(err.Error())
+		return
+	}
+
+	awsClient, err := aws.NewS3Client(ev, zapLogger)
+	if err != nil {
+		zapLogger.Error(err.Error())
+		return
+	}
+
+	imageRepo := repository.NewImageRepository(dbClient)
+	storageRepo := s3Repo.NewS3Repository(ev, awsClient)
+	uc := imageApp.NewPruneImagesUseCase(imageRepo, storageRepo, ev.AwsS3BucketName)
+
+	report, err := uc.Exec(context.Background(), imageApp.PruneImagesInputDto{
+		All:     *all,
+		Filters: rawFilters.toMap(),
+		DryRun:  *dryRun,
+	})
+	if err != nil {
+		zapLogger.Error(err.Error())
+		return
+	}
+
+	zapLogger.Info(fmt.Sprintf("pruned %d image(s), reclaimed %d bytes, %d error(s)", len(report.Deleted), report.Reclaimed, len(report.Errors)))
+	for _, path := range report.Deleted {
+		zapLogger.Info("deleted " + path)
+	}
+	for _, pruneErr := range report.Errors {
+		zapLogger.Error(pruneErr.Error())
+	}
+}
+
+// filterFlags collects repeated -filter key=value flags into a
+// map[string][]string, matching PruneImagesInputDto.Filters.
+type filterFlags []string
+
+func (f *filterFlags) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *filterFlags) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+func (f *filterFlags) toMap() map[string][]string {
+	filters := make(map[string][]string, len(*f))
+	for _, raw := range *f {
+		key, value, found := strings.Cut(raw, "=")
+		if !found {
+			continue
+		}
+		filters[key] = append(filters[key], value)
+	}
+	return filters
+}
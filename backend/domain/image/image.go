@@ -1,7 +1,7 @@
 package image
 
 import (
-	tagDomain "github.com/keito-isurugi/various-app/domain/tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
 	"time"
 )
 
@@ -9,12 +9,36 @@ type Image struct {
 	ID          int    `gorm:"primaryKey"`
 	ImagePath   string `gorm:"column:image_path"`
 	DisplayFlag bool   `gorm:"column:display_flag"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   *time.Time
-	Tags        []tagDomain.Tag `gorm:"many2many:image_tags;joinForeignKey:ImageID;JoinReferences:TagID"`
+	// Digest is the SHA-256 content hash of the uploaded file, used as the
+	// canonical S3 object key so identical uploads dedupe to one row.
+	Digest string `gorm:"column:digest;uniqueIndex"`
+	// ParentID points at the Image this one was derived from (a crop,
+	// re-encode, watermarked variant, ...), or nil for an original upload.
+	ParentID *int `gorm:"column:parent_id"`
+	// Status is ImageStatusPending from the moment RequestImageUploadUseCase
+	// inserts the row (before the client has necessarily finished PUTting to
+	// S3) until ConfirmImageUploadUseCase verifies the object via Stat and
+	// flips it to ImageStatusCommitted. Rows created through the legacy
+	// multipart PutObject path are committed immediately since the bytes are
+	// already in hand by the time the row is created.
+	Status string `gorm:"column:status"`
+	// ETag and SizeBytes are recorded by ConfirmImageUploadUseCase from the
+	// storage backend's Stat response, so downstream code has them without
+	// re-querying S3/MinIO.
+	ETag      string `gorm:"column:etag"`
+	SizeBytes int64  `gorm:"column:size_bytes"`
+	CreatedBy string `gorm:"column:created_by"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+	Tags      []tagDomain.Tag `gorm:"many2many:image_tags;joinForeignKey:ImageID;JoinReferences:TagID"`
 }
 
+const (
+	ImageStatusPending   = "pending"
+	ImageStatusCommitted = "committed"
+)
+
 type ListImages []Image
 
 func NewImage(
@@ -36,3 +60,47 @@ func NewImage(
 type ListImagesNoTaggedTags struct {
 	TagIDs []int
 }
+
+// PruneImagesQuery selects prune candidates, in the spirit of `docker image
+// prune --filter`. All makes every image a candidate, tagged or not, narrowed
+// only by Filters; Filters recognizes "untagged"/"dangling" (both mean "has
+// zero image_tags rows", the default candidate set), "display_flag"
+// ("true"/"false"), "before" (an RFC3339 timestamp; only images created
+// strictly before it), and "label" ("namespace" or "namespace=variant",
+// matched against the image's tags; multiple values are OR'd). "label"
+// requires All, since the default candidate set already excludes every
+// image a label match could hit; PruneImages returns an error rather than
+// silently running an empty prune when the two are combined. Unrecognized
+// filter keys likewise make PruneImages return an error rather than being
+// ignored, since silently ignoring one would broaden this destructive
+// operation's scope beyond what the caller asked for.
+type PruneImagesQuery struct {
+	All     bool
+	Filters map[string][]string
+}
+
+// SearchImagesQuery filters images by their associated tags.
+// Match controls the set semantics applied to TagIDs:
+//   - "all": images tagged with every one of TagIDs
+//   - "any": images tagged with at least one of TagIDs
+//   - "none": images tagged with none of TagIDs
+type SearchImagesQuery struct {
+	TagIDs []int
+	Match  string
+	Limit  int
+	Cursor int
+}
+
+// ListImagesQuery filters and paginates the plain image listing. Pagination
+// uses a keyset cursor on (created_at, id) rather than offset/limit so that
+// pages stay stable while rows are concurrently inserted.
+type ListImagesQuery struct {
+	Limit           int
+	CursorCreatedAt *time.Time
+	CursorID        int
+	DisplayFlag     *bool
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	Q               string
+	IncludeDeleted  bool
+}
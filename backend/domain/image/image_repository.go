@@ -7,9 +7,49 @@ import (
 
 type ImageRepository interface {
 	ListImages(ctx context.Context) (*ListImages, error)
+	// ListImagesPaged returns a keyset page of images ordered by
+	// (created_at, id), honoring query's filters and soft-delete visibility.
+	ListImagesPaged(ctx context.Context, query *ListImagesQuery) (*ListImages, error)
 	GetImage(ctx context.Context, id int) (*Image, error)
+	// FindImage looks up an Image by id; found is false (not an error) when
+	// no row matches, so callers like the history walk can stop safely on a
+	// missing parent instead of treating it as a hard failure.
+	FindImage(ctx context.Context, id int) (*Image, bool, error)
+	// FindImageByDigest looks up an Image by its content digest for
+	// upload-time dedup; found is false (not an error) when no row matches.
+	FindImageByDigest(ctx context.Context, digest string) (*Image, bool, error)
+	// FindImageByPath looks up an Image by its image_path (the S3 object
+	// key); found is false (not an error) when no row matches. Used by
+	// ConfirmImageUploadUseCase to locate the pending row a presigned
+	// upload's object key belongs to.
+	FindImageByPath(ctx context.Context, imagePath string) (*Image, bool, error)
 	DeleteImage(ctx context.Context, path string) error
 	RegisterImage(ctx context.Context, img *Image) (string, error)
+	// CommitImageUpload flips a pending Image row to ImageStatusCommitted,
+	// makes it visible (DisplayFlag), and records the ETag/size the storage
+	// backend reported for its object, once ConfirmImageUploadUseCase has
+	// verified it landed.
+	CommitImageUpload(ctx context.Context, imageID int, etag string, sizeBytes int64) error
+	// GetUntaggedImagesByTags and GetTaggedImagesByTags are thin wrappers
+	// around Search, kept so existing callers don't need to build a
+	// TagQuery for these two common shapes themselves.
 	GetUntaggedImagesByTags(ctx context.Context, tagIDs *ListImagesNoTaggedTags) (*ListImages, error)
 	GetTaggedImagesByTags(ctx context.Context, tagIDs []int) (*ListImages, error)
+	// Search compiles query (a TagQuery AST) to a single SQL statement and
+	// returns the matching page of images ordered by (created_at, id)
+	// descending, plus the total match count across all pages.
+	// preloadTags toggles eager-loading each Image's Tags.
+	Search(ctx context.Context, query TagQuery, page Pagination, preloadTags bool) (*ListImages, int64, error)
+	// SearchImages returns the page of images matching query, plus the total
+	// number of matches across all pages, for keyset pagination on id.
+	SearchImages(ctx context.Context, query *SearchImagesQuery) (*ListImages, int64, error)
+	// ListTagNamesByImageIDs returns each image's tag names keyed by image
+	// id, built from a single image_tags JOIN tags query, so callers like
+	// the history walk can annotate a chain of images without N+1 queries.
+	ListTagNamesByImageIDs(ctx context.Context, imageIDs []int) (map[int][]string, error)
+	// PruneImages returns every Image matching query that is safe to
+	// garbage-collect; it does not delete anything itself, so callers like
+	// PruneImagesUseCase can clean up the backing S3 object before removing
+	// the row.
+	PruneImages(ctx context.Context, query *PruneImagesQuery) ([]Image, error)
 }
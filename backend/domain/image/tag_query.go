@@ -0,0 +1,67 @@
+package image
+
+// TagQuery is a small AST over an image's tag set. ImageRepository.Search
+// compiles it to a single SQL statement, instead of the repo gaining one
+// bespoke method per filter shape (the pattern GetUntaggedImagesByTags and
+// GetTaggedImagesByTags already outgrew).
+type TagQuery interface {
+	isTagQuery()
+}
+
+// Any matches images tagged with at least one of TagIDs.
+type Any struct {
+	TagIDs []int
+}
+
+// All matches images tagged with every one of TagIDs.
+type All struct {
+	TagIDs []int
+}
+
+// Not matches images that do not satisfy Inner.
+type Not struct {
+	Inner TagQuery
+}
+
+// And matches images that satisfy every one of Clauses.
+type And struct {
+	Clauses []TagQuery
+}
+
+// Or matches images that satisfy at least one of Clauses. Compiles to OR'd
+// id-membership conditions, the set-algebra equivalent of a UNION over
+// each clause's matching image ids.
+type Or struct {
+	Clauses []TagQuery
+}
+
+// TagQueryOp is the comparison HasCount applies to an image's tag count.
+type TagQueryOp string
+
+const (
+	TagQueryOpEQ TagQueryOp = "="
+	TagQueryOpGE TagQueryOp = ">="
+	TagQueryOpLE TagQueryOp = "<="
+)
+
+// HasCount matches images whose total tag count compares to N via Op.
+type HasCount struct {
+	Op TagQueryOp
+	N  int
+}
+
+func (Any) isTagQuery()      {}
+func (All) isTagQuery()      {}
+func (Not) isTagQuery()      {}
+func (And) isTagQuery()      {}
+func (Or) isTagQuery()       {}
+func (HasCount) isTagQuery() {}
+
+// Pagination is a plain offset/limit page request for Search. A zero Limit
+// means "no limit" rather than falling back to a default page size, so the
+// existing unpaginated GetUntaggedImagesByTags/GetTaggedImagesByTags can be
+// expressed as thin wrappers around Search without changing behavior.
+type Pagination struct {
+	Limit  int
+	Offset int
+}
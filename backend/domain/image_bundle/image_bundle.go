@@ -0,0 +1,13 @@
+package image_bundle
+
+// ManifestEntry describes one image in a bulk import/export tar's
+// manifest.json, pairing its content digest with the references and tags
+// that should exist for it on the receiving side.
+type ManifestEntry struct {
+	Digest      string   `json:"digest"`
+	References  []string `json:"references"`
+	Tags        []string `json:"tags"`
+	DisplayFlag bool     `json:"display_flag"`
+}
+
+type Manifest []ManifestEntry
@@ -0,0 +1,26 @@
+//go:generate mockgen -source=image_bundle_repository.go -destination=./mock/image_bundle_repository_mock.go
+package image_bundle
+
+import (
+	"context"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+)
+
+// ImageBundleRepository persists the result of importing a registry-style
+// tar bundle: for each manifest entry it upserts the image row, tag rows,
+// image_tags joins, and image_references rows in a single transaction, so a
+// failure partway through rolls back the whole import.
+type ImageBundleRepository interface {
+	Import(ctx context.Context, entries Manifest) error
+	// Export returns every non-deleted image matching query (the union of
+	// ImageIDs and TagNames), or every image if query is empty, with Tags
+	// preloaded, for building the export manifest.
+	Export(ctx context.Context, query *ExportQuery) (*imageDomain.ListImages, error)
+}
+
+// ExportQuery filters which images a bulk export includes.
+type ExportQuery struct {
+	ImageIDs []int
+	TagNames []string
+}
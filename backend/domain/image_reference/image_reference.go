@@ -0,0 +1,15 @@
+package image_reference
+
+import "time"
+
+// ImageReference maps a human-readable (name, tag) pair to an Image, the
+// way a container registry tag maps to a content-addressable digest.
+type ImageReference struct {
+	ID        int    `gorm:"primaryKey"`
+	ImageID   int    `gorm:"column:image_id"`
+	Name      string `gorm:"column:name"`
+	Tag       string `gorm:"column:tag"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
@@ -0,0 +1,17 @@
+//go:generate mockgen -source=image_reference_repository.go -destination=./mock/image_reference_repository_mock.go
+package image_reference
+
+import (
+	"context"
+)
+
+type ImageReferenceRepository interface {
+	// Tag points (name, tag) at imageID, creating the reference row if it
+	// doesn't exist yet or repointing it if it does (re-tagging).
+	Tag(ctx context.Context, imageID int, name, tag string) error
+	Untag(ctx context.Context, name, tag string) error
+	Resolve(ctx context.Context, name, tag string) (*ImageReference, error)
+	// ListByImageID returns every reference pointing at imageID, e.g. for
+	// inclusion in a bulk export manifest.
+	ListByImageID(ctx context.Context, imageID int) ([]ImageReference, error)
+}
@@ -10,4 +10,13 @@ type ImageTagRepository interface {
 	GetImageTag(ctx context.Context, id int) (*ImageTag, error)
 	DeleteImageTag(ctx context.Context, id int) error
 	RegisterImageTag(ctx context.Context, imgTag *ImageTag) (int, error)
+	// ReplaceImageTags sets the tags on imageID to exactly tagIDs: it diffs
+	// against the existing rows and inserts/soft-deletes only what changed,
+	// atomically.
+	ReplaceImageTags(ctx context.Context, imageID int, tagIDs []int) error
+	// UntagImage removes the join row for (imageID, tagID) if present,
+	// leaving the image and its other tag associations untouched. The bool
+	// reports whether a row was actually removed, so callers can
+	// distinguish an idempotent no-op from an error.
+	UntagImage(ctx context.Context, imageID, tagID int) (bool, error)
 }
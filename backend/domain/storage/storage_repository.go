@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"context"
+	"errors"
+	"io"
 	"mime/multipart"
+	"net/http"
+	"time"
 )
 
 type ObjectInput struct {
@@ -10,7 +15,74 @@ type ObjectInput struct {
 	FileContent []byte
 }
 
+// PutResult is what a successful upload produced: the URL downstream code
+// can read the object back from, the backend's ETag (so callers like image
+// registration can persist it for change detection), and the object's
+// final size/content type.
+type PutResult struct {
+	URL         string
+	ETag        string
+	Size        int64
+	ContentType string
+}
+
+// StatResult is what Driver.Stat reports about an existing object.
+type StatResult struct {
+	Size        int64
+	ETag        string
+	ContentType string
+}
+
+// ErrObjectNotFound is returned by Driver.Stat when objectKey doesn't exist,
+// so StorageRepository can translate it into HeadObject's bool result
+// without leaking a backend-specific error type.
+var ErrObjectNotFound = errors.New("storage: object not found")
+
+// Driver is the low-level, backend-specific operation set. StorageRepository
+// implementations compose over whichever Driver NewS3Repository selects
+// based on env.Values.StorageBackend ("aws" or "minio"), so callers above
+// the storage package stay backend-agnostic.
+type Driver interface {
+	Put(ctx context.Context, bucketName, objectKey, contentType string, body io.ReadSeeker) (*PutResult, error)
+	Delete(ctx context.Context, bucketName, objectKey string) error
+	// Stat returns ErrObjectNotFound (not a backend-specific error) when
+	// objectKey doesn't exist.
+	Stat(ctx context.Context, bucketName, objectKey string) (*StatResult, error)
+	PresignGet(ctx context.Context, bucketName, objectKey string, expires time.Duration) (string, error)
+	// PresignPut returns the presigned PUT URL plus any headers the caller
+	// must send alongside the PUT for the signature to validate (e.g.
+	// Content-Type). MinIO's presigned URLs don't enforce headers this way,
+	// so minioDriver always returns a nil http.Header.
+	PresignPut(ctx context.Context, bucketName, objectKey, contentType string, expires time.Duration) (string, http.Header, error)
+	// List returns the keys under prefix in bucketName.
+	List(ctx context.Context, bucketName, prefix string) ([]string, error)
+}
+
+// StorageRepository calls accept a context so that a client disconnect (or
+// the per-request timeout in server.SetupRouter) aborts the underlying
+// storage request instead of leaving it running to completion in the
+// background.
 type StorageRepository interface {
-	PutObject(file *multipart.FileHeader, bucketName, objectKey string) (string, error)
-	DeleteObject(attachmentFile string) error
+	PutObject(ctx context.Context, file *multipart.FileHeader, bucketName, objectKey string) (*PutResult, error)
+	// DeleteObject removes objectKey from bucketName; objectKey is the bare
+	// key (e.g. an Image's Digest), not a full endpoint/bucket/key URL.
+	DeleteObject(ctx context.Context, bucketName, objectKey string) error
+	// PresignPutObject issues a time-limited URL the caller can PUT the
+	// object to directly, bypassing the Echo server, plus any headers the
+	// client must send with that PUT.
+	PresignPutObject(ctx context.Context, bucketName, objectKey, contentType string, expires time.Duration) (string, http.Header, error)
+	// PresignGetObject issues a time-limited URL the caller can GET the
+	// object from directly.
+	PresignGetObject(ctx context.Context, bucketName, objectKey string, expires time.Duration) (string, error)
+	// HeadObject reports whether objectKey exists in bucketName.
+	HeadObject(ctx context.Context, bucketName, objectKey string) (bool, error)
+	// StatObjectSize returns objectKey's size in bytes, for callers (e.g.
+	// PruneImagesUseCase) that need to report reclaimed storage before
+	// deleting the object.
+	StatObjectSize(ctx context.Context, bucketName, objectKey string) (int64, error)
+	// StatObject returns objectKey's ETag and size; ok is false (not an
+	// error) when the object doesn't exist, mirroring HeadObject's
+	// existence-check style. Used by ConfirmImageUploadUseCase to record
+	// what actually landed in storage.
+	StatObject(ctx context.Context, bucketName, objectKey string) (etag string, size int64, ok bool, err error)
 }
@@ -3,8 +3,17 @@ package tag
 import "time"
 
 type Tag struct {
-	ID        int    `gorm:"primaryKey"`
-	Name      string `gorm:"column:name"`
+	ID int `gorm:"primaryKey"`
+	// Name is the canonical "namespace/name:variant" string produced by
+	// reference.ParseTag (namespace and variant are optional), unique so
+	// FindTagByName can resolve it back to an id.
+	Name string `gorm:"column:name;uniqueIndex"`
+	// Namespace, LocalName and Variant are Name's parsed components,
+	// stored alongside it so callers can query by namespace prefix (e.g.
+	// all "character/*" tags) or by variant without re-parsing Name.
+	Namespace string `gorm:"column:namespace"`
+	LocalName string `gorm:"column:local_name"`
+	Variant   string `gorm:"column:variant"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
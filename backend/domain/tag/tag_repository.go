@@ -11,4 +11,17 @@ type TagRepository interface {
 	RegisterTag(ctx context.Context, tag *Tag) (int, error)
 	UpdateTag(ctx context.Context, tag *Tag) error
 	DeleteTag(ctx context.Context, id int) error
+	// FindTagByName looks up a Tag by name; found is false (not an error)
+	// when no row matches, so callers can resolve a tag name to an id
+	// without treating a miss as a hard failure.
+	FindTagByName(ctx context.Context, name string) (*Tag, bool, error)
+	// FindTagsByNames resolves names to ids in a single query, keyed by
+	// name. A name with no matching row is simply absent from the map.
+	FindTagsByNames(ctx context.Context, names []string) (map[string]int, error)
+	// ListTagsByNamespace returns every Tag under namespace, for
+	// hierarchical browsing (e.g. all "character/*" tags).
+	ListTagsByNamespace(ctx context.Context, namespace string) (*ListTags, error)
+	// ListTagsByVariant returns every Tag sharing variant, regardless of
+	// namespace.
+	ListTagsByVariant(ctx context.Context, variant string) (*ListTags, error)
 }
@@ -13,12 +13,15 @@ type Values struct {
 	DB
 	TestDB
 	AWS
+	Storage
 	Debug bool `default:"true" split_words:"true"`
 }
 
 type Server struct {
-	BeServerPort string `default:"8080" split_words:"true"`
-	FeServerPort string `default:"3000" split_words:"true"`
+	BeServerPort          string `default:"8080" split_words:"true"`
+	FeServerPort          string `default:"3000" split_words:"true"`
+	GrpcServerPort        string `default:"9090" split_words:"true"`
+	RequestTimeoutSeconds int    `default:"30" split_words:"true"`
 }
 
 type DB struct {
@@ -38,12 +41,27 @@ type TestDB struct {
 }
 
 type AWS struct {
-	AwsRegion          string `required:"true" split_words:"true"`
-	AwsAccessKeyID     string `split_words:"true"`
-	AwsSecretAccessKey string `split_words:"true"`
-	AwsEndpoint        string `split_words:"true"`
-	AwsEndpointLocal   string `split_words:"true"`
-	AwsS3BucketName    string `required:"true" split_words:"true"`
+	AwsRegion               string `required:"true" split_words:"true"`
+	AwsAccessKeyID          string `split_words:"true"`
+	AwsSecretAccessKey      string `split_words:"true"`
+	AwsS3Endpoint           string `split_words:"true"`
+	AwsS3EndpointExternal   string `split_words:"true"`
+	AwsS3BucketName         string `required:"true" split_words:"true"`
+	AwsPresignURLTTLSeconds int    `default:"900" split_words:"true"`
+}
+
+// Storage selects and configures the storage.Driver NewS3Repository
+// constructs. AWS above still carries the AWS SDK-specific settings (and
+// stays required for the "aws" backend); these fields are either shared
+// across backends or specific to a non-AWS (e.g. MinIO) endpoint.
+type Storage struct {
+	StorageBackend         string `default:"aws" split_words:"true"`
+	StorageRegion          string `split_words:"true"`
+	StorageUseSSL          bool   `default:"true" split_words:"true"`
+	StorageForcePathStyle  bool   `default:"true" split_words:"true"`
+	StorageAccessKeyID     string `split_words:"true"`
+	StorageSecretAccessKey string `split_words:"true"`
+	StorageEndpoint        string `split_words:"true"`
 }
 
 func NewValue() (*Values, error) {
@@ -1,18 +1,25 @@
 package aws
 
 import (
+	"net/http"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"go.uber.org/zap"
 
-	"github.com/keito-isurugi/various-app/infrastructure/env"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
+	"github.com/keito-isurugi/kei-talk/infrastructure/httpx"
 )
 
-func NewS3Client(ev *env.Values) (s3iface.S3API, error) {
+func NewS3Client(ev *env.Values, logger *zap.Logger) (s3iface.S3API, error) {
 	awsConfig := &aws.Config{
 		Region:           aws.String(ev.AwsRegion),
 		S3ForcePathStyle: aws.Bool(true),
+		HTTPClient: &http.Client{
+			Transport: httpx.NewLoggingRoundTripper(logger, http.DefaultTransport),
+		},
 	}
 
 	// エンドポイント設定がある場合のみ、エンドポイントを設定
@@ -0,0 +1,304 @@
+// Package grpc exposes the same use cases wired in server.SetupRouter over
+// gRPC, generated from api/v1/various_app.proto.
+//
+// Run `make proto` to (re)generate the v1 package from the .proto definition
+// before building this package.
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	v1 "github.com/keito-isurugi/kei-talk/api/v1"
+	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	imageTagApp "github.com/keito-isurugi/kei-talk/application/image_tag"
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+)
+
+// imagesServer adapts the context.Context-based use cases to the generated
+// v1.ImagesServer interface.
+type imagesServer struct {
+	v1.UnimplementedImagesServer
+
+	listImagesUseCase    imageApp.ListImagesUseCase
+	getImageUseCase      imageApp.GetImageUseCase
+	deleteImageUseCase   imageApp.DeleteImageUseCase
+	registerImageUseCase imageApp.RegisterImageUseCase
+}
+
+func NewImagesServer(
+	listImagesUseCase imageApp.ListImagesUseCase,
+	getImageUseCase imageApp.GetImageUseCase,
+	deleteImageUseCase imageApp.DeleteImageUseCase,
+	registerImageUseCase imageApp.RegisterImageUseCase,
+) v1.ImagesServer {
+	return &imagesServer{
+		listImagesUseCase:    listImagesUseCase,
+		getImageUseCase:      getImageUseCase,
+		deleteImageUseCase:   deleteImageUseCase,
+		registerImageUseCase: registerImageUseCase,
+	}
+}
+
+func (s *imagesServer) Get(ctx context.Context, req *v1.GetImageRequest) (*v1.Image, error) {
+	img, err := s.getImageUseCase.Exec(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Image{
+		Id:          int32(img.ID),
+		ImagePath:   img.ImagePath,
+		DisplayFlag: img.DisplayFlag,
+	}, nil
+}
+
+func (s *imagesServer) List(ctx context.Context, _ *v1.ListImagesRequest) (*v1.ListImagesResponse, error) {
+	out, err := s.listImagesUseCase.Exec(ctx, imageApp.ListImagesInputDto{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.ListImagesResponse{Images: make([]*v1.Image, len(out.Images))}
+	for i, img := range out.Images {
+		tags := make([]*v1.Tag, len(img.Tags))
+		for j, tag := range img.Tags {
+			tags[j] = &v1.Tag{Id: int32(tag.ID), Name: tag.Name}
+		}
+		res.Images[i] = &v1.Image{
+			Id:          int32(img.ID),
+			ImagePath:   img.ImagePath,
+			DisplayFlag: img.DisplayFlag,
+			Tags:        tags,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *imagesServer) Put(ctx context.Context, req *v1.PutImageRequest) (*v1.Image, error) {
+	// gRPC の PutImageRequest はバイト列を持たずパスのみを受け取るため、
+	// パス自体のダイジェストを内容アドレスの代用として用いる。
+	digest := fmt.Sprintf("%x", sha256.Sum256([]byte(req.GetImagePath())))
+
+	path, err := s.registerImageUseCase.Exec(ctx, digest, req.GetImagePath(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Image{ImagePath: path}, nil
+}
+
+func (s *imagesServer) Delete(ctx context.Context, req *v1.DeleteImageRequest) (*v1.DeleteImageResponse, error) {
+	if err := s.deleteImageUseCase.Exec(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteImageResponse{}, nil
+}
+
+// tagsServer adapts the context.Context-based use cases to the generated
+// v1.TagsServer interface.
+type tagsServer struct {
+	v1.UnimplementedTagsServer
+
+	listTagsUseCase    tagApp.ListTagsUseCase
+	getTagUseCase      tagApp.GetTagUseCase
+	registerTagUseCase tagApp.RegisterTagUseCase
+	updateTagUseCase   tagApp.UpdateTagUseCase
+	deleteTagUseCase   tagApp.DeleteTagUseCase
+}
+
+func NewTagsServer(
+	listTagsUseCase tagApp.ListTagsUseCase,
+	getTagUseCase tagApp.GetTagUseCase,
+	registerTagUseCase tagApp.RegisterTagUseCase,
+	updateTagUseCase tagApp.UpdateTagUseCase,
+	deleteTagUseCase tagApp.DeleteTagUseCase,
+) v1.TagsServer {
+	return &tagsServer{
+		listTagsUseCase:    listTagsUseCase,
+		getTagUseCase:      getTagUseCase,
+		registerTagUseCase: registerTagUseCase,
+		updateTagUseCase:   updateTagUseCase,
+		deleteTagUseCase:   deleteTagUseCase,
+	}
+}
+
+func (s *tagsServer) Get(ctx context.Context, req *v1.GetTagRequest) (*v1.Tag, error) {
+	t, err := s.getTagUseCase.Exec(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Tag{Id: int32(t.ID), Name: t.Name}, nil
+}
+
+func (s *tagsServer) List(ctx context.Context, _ *v1.ListTagsRequest) (*v1.ListTagsResponse, error) {
+	out, err := s.listTagsUseCase.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.ListTagsResponse{Tags: make([]*v1.Tag, len(*out))}
+	for i, t := range *out {
+		res.Tags[i] = &v1.Tag{Id: int32(t.ID), Name: t.Name}
+	}
+
+	return res, nil
+}
+
+// Put registers req as a new tag when it carries no id, and updates the
+// existing tag otherwise, mirroring the REST routes: POST /api/tags when
+// there is no id, PUT /api/tags/:id otherwise.
+func (s *tagsServer) Put(ctx context.Context, req *v1.PutTagRequest) (*v1.Tag, error) {
+	if req.GetId() == 0 {
+		id, err := s.registerTagUseCase.Exec(ctx, req.GetName())
+		if err != nil {
+			return nil, err
+		}
+
+		return &v1.Tag{Id: int32(id), Name: req.GetName()}, nil
+	}
+
+	err := s.updateTagUseCase.Exec(ctx, tagApp.TagUseCaseInputDto{
+		ID:   int(req.GetId()),
+		Name: req.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.Tag{Id: req.GetId(), Name: req.GetName()}, nil
+}
+
+func (s *tagsServer) Delete(ctx context.Context, req *v1.DeleteTagRequest) (*v1.DeleteTagResponse, error) {
+	if err := s.deleteTagUseCase.Exec(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteTagResponse{}, nil
+}
+
+// imageTagsServer adapts the context.Context-based use cases to the
+// generated v1.ImageTagsServer interface.
+type imageTagsServer struct {
+	v1.UnimplementedImageTagsServer
+
+	listImageTagsUseCase            imageTagApp.ListImageTagsUseCase
+	getImageTagUseCase              imageTagApp.GetImageTagUseCase
+	registerImageTagUseCase         imageTagApp.RegisterImageTagUseCase
+	deleteImageTagUseCase           imageTagApp.DeleteImageTagUseCase
+	registerMultipleImageTagUseCase imageTagApp.RegisterMultipleImageTagsUseCase
+	deleteMultipleImageTagUseCase   imageTagApp.DeleteMultipleImageTagsUseCase
+}
+
+func NewImageTagsServer(
+	listImageTagsUseCase imageTagApp.ListImageTagsUseCase,
+	getImageTagUseCase imageTagApp.GetImageTagUseCase,
+	registerImageTagUseCase imageTagApp.RegisterImageTagUseCase,
+	deleteImageTagUseCase imageTagApp.DeleteImageTagUseCase,
+	registerMultipleImageTagUseCase imageTagApp.RegisterMultipleImageTagsUseCase,
+	deleteMultipleImageTagUseCase imageTagApp.DeleteMultipleImageTagsUseCase,
+) v1.ImageTagsServer {
+	return &imageTagsServer{
+		listImageTagsUseCase:            listImageTagsUseCase,
+		getImageTagUseCase:              getImageTagUseCase,
+		registerImageTagUseCase:         registerImageTagUseCase,
+		deleteImageTagUseCase:           deleteImageTagUseCase,
+		registerMultipleImageTagUseCase: registerMultipleImageTagUseCase,
+		deleteMultipleImageTagUseCase:   deleteMultipleImageTagUseCase,
+	}
+}
+
+func (s *imageTagsServer) Get(ctx context.Context, req *v1.GetImageTagRequest) (*v1.ImageTag, error) {
+	it, err := s.getImageTagUseCase.Exec(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImageTag{Id: int32(it.ID), ImageId: int32(it.ImageID), TagId: int32(it.TagID)}, nil
+}
+
+func (s *imageTagsServer) List(ctx context.Context, _ *v1.ListImageTagsRequest) (*v1.ListImageTagsResponse, error) {
+	out, err := s.listImageTagsUseCase.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.ListImageTagsResponse{ImageTags: make([]*v1.ImageTag, len(*out))}
+	for i, it := range *out {
+		res.ImageTags[i] = &v1.ImageTag{Id: int32(it.ID), ImageId: int32(it.ImageID), TagId: int32(it.TagID)}
+	}
+
+	return res, nil
+}
+
+func (s *imageTagsServer) Put(ctx context.Context, req *v1.PutImageTagRequest) (*v1.ImageTag, error) {
+	id, err := s.registerImageTagUseCase.Exec(ctx, imageTagApp.ImageTagUseCaseInputDto{
+		ImageID: int(req.GetImageId()),
+		TagID:   int(req.GetTagId()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImageTag{Id: int32(id), ImageId: req.GetImageId(), TagId: req.GetTagId()}, nil
+}
+
+func (s *imageTagsServer) Delete(ctx context.Context, req *v1.DeleteImageTagRequest) (*v1.DeleteImageTagResponse, error) {
+	if err := s.deleteImageTagUseCase.Exec(ctx, int(req.GetId())); err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteImageTagResponse{}, nil
+}
+
+func (s *imageTagsServer) RegisterMultiple(ctx context.Context, req *v1.RegisterMultipleImageTagsRequest) (*v1.RegisterMultipleImageTagsResponse, error) {
+	ids, err := s.registerMultipleImageTagUseCase.Exec(ctx, imageTagApp.UpdateMultipleImageTagsUseCaseInputDto{
+		ImageIDs: int32SliceToInt(req.GetImageIds()),
+		TagIDs:   int32SliceToInt(req.GetTagIds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &v1.RegisterMultipleImageTagsResponse{Ids: make([]int32, len(ids))}
+	for i, id := range ids {
+		res.Ids[i] = int32(id)
+	}
+
+	return res, nil
+}
+
+func (s *imageTagsServer) DeleteMultiple(ctx context.Context, req *v1.DeleteMultipleImageTagsRequest) (*v1.DeleteMultipleImageTagsResponse, error) {
+	err := s.deleteMultipleImageTagUseCase.Exec(ctx, imageTagApp.UpdateMultipleImageTagsUseCaseInputDto{
+		ImageIDs: int32SliceToInt(req.GetImageIds()),
+		TagIDs:   int32SliceToInt(req.GetTagIds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.DeleteMultipleImageTagsResponse{}, nil
+}
+
+func int32SliceToInt(s []int32) []int {
+	out := make([]int, len(s))
+	for i, v := range s {
+		out[i] = int(v)
+	}
+	return out
+}
+
+// NewServer registers the Images, Tags, and ImageTags gRPC services.
+func NewServer(imagesSrv v1.ImagesServer, tagsSrv v1.TagsServer, imageTagsSrv v1.ImageTagsServer) *grpc.Server {
+	s := grpc.NewServer()
+	v1.RegisterImagesServer(s, imagesSrv)
+	v1.RegisterTagsServer(s, tagsSrv)
+	v1.RegisterImageTagsServer(s, imageTagsSrv)
+	return s
+}
@@ -0,0 +1,78 @@
+// Package httpx provides http.RoundTripper wrappers for outbound clients
+// (currently the AWS S3 client), following the wrap-and-forward pattern
+// used by k8s.io/client-go/transport's DebuggingRoundTripper: each wrapper
+// embeds the transport it decorates and forwards CancelRequest to it, so a
+// cancelled context still reaches the innermost transport.
+package httpx
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RoundTripperWrapper decorates an http.RoundTripper while exposing the
+// transport it wraps, so callers can walk the chain down to the transport
+// that actually owns the connection.
+type RoundTripperWrapper interface {
+	http.RoundTripper
+	WrappedRoundTripper() http.RoundTripper
+}
+
+// canceler is satisfied by http.Transport and other RoundTrippers that
+// support the legacy cancellation hook alongside context cancellation.
+type canceler interface {
+	CancelRequest(req *http.Request)
+}
+
+// LoggingRoundTripper logs request/response metadata around a delegated
+// RoundTrip call.
+type LoggingRoundTripper struct {
+	logger *zap.Logger
+	rt     http.RoundTripper
+}
+
+func NewLoggingRoundTripper(logger *zap.Logger, rt http.RoundTripper) *LoggingRoundTripper {
+	return &LoggingRoundTripper{
+		logger: logger,
+		rt:     rt,
+	}
+}
+
+func (lrt *LoggingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	resp, err := lrt.rt.RoundTrip(req)
+	if err != nil {
+		lrt.logger.Error("http request failed",
+			zap.String("method", req.Method),
+			zap.String("url", req.URL.String()),
+			zap.Duration("duration", time.Since(start)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	lrt.logger.Debug("http request completed",
+		zap.String("method", req.Method),
+		zap.String("url", req.URL.String()),
+		zap.Int("status", resp.StatusCode),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return resp, nil
+}
+
+func (lrt *LoggingRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return lrt.rt
+}
+
+// CancelRequest forwards cancellation to the wrapped transport if it
+// supports the hook, so a client disconnect mid-upload still aborts the
+// in-flight S3 request instead of leaving it to run to completion.
+func (lrt *LoggingRoundTripper) CancelRequest(req *http.Request) {
+	if c, ok := lrt.rt.(canceler); ok {
+		c.CancelRequest(req)
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	imageBundleDomain "github.com/keito-isurugi/kei-talk/domain/image_bundle"
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
+	tagDomain "github.com/keito-isurugi/kei-talk/domain/tag"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+	"github.com/keito-isurugi/kei-talk/reference"
+)
+
+type imageBundleRepository struct {
+	dbClient db.Client
+}
+
+func NewImageBundleRepository(dbClient db.Client) imageBundleDomain.ImageBundleRepository {
+	return &imageBundleRepository{
+		dbClient: dbClient,
+	}
+}
+
+func (r *imageBundleRepository) Import(ctx context.Context, entries imageBundleDomain.Manifest) error {
+	return r.dbClient.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, entry := range entries {
+			img, err := upsertImageByDigest(tx, entry.Digest, entry.DisplayFlag)
+			if err != nil {
+				return err
+			}
+
+			tagIDs, err := upsertTagsByName(tx, entry.Tags)
+			if err != nil {
+				return err
+			}
+
+			if err := replaceImageTagJoins(tx, img.ID, tagIDs); err != nil {
+				return err
+			}
+
+			for _, raw := range entry.References {
+				ref, err := reference.ParseString(raw)
+				if err != nil {
+					return err
+				}
+				if err := upsertImageReference(tx, img.ID, ref.Name, ref.Tag); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+func upsertImageByDigest(tx *gorm.DB, digest string, displayFlag bool) (*imageDomain.Image, error) {
+	var img imageDomain.Image
+	err := tx.Where("digest = ?", digest).First(&img).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		img = imageDomain.Image{ImagePath: digest, DisplayFlag: displayFlag, Digest: digest}
+		if err := tx.Create(&img).Error; err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	return &img, nil
+}
+
+func upsertTagsByName(tx *gorm.DB, names []string) ([]int, error) {
+	tagIDs := make([]int, 0, len(names))
+	for _, name := range names {
+		var tag tagDomain.Tag
+		err := tx.Where("name = ?", name).First(&tag).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			ref, err := reference.ParseTag(name)
+			if err != nil {
+				return nil, err
+			}
+			tag = tagDomain.Tag{Name: ref.String(), Namespace: ref.Namespace, LocalName: ref.Name, Variant: ref.Variant}
+			if err := tx.Create(&tag).Error; err != nil {
+				return nil, err
+			}
+		case err != nil:
+			return nil, err
+		}
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs, nil
+}
+
+func replaceImageTagJoins(tx *gorm.DB, imageID int, tagIDs []int) error {
+	if err := tx.Model(&imageTagDomain.ImageTag{}).
+		Where("image_id = ?", imageID).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	joins := make([]imageTagDomain.ImageTag, len(tagIDs))
+	for i, tagID := range tagIDs {
+		joins[i] = imageTagDomain.ImageTag{ImageID: imageID, TagID: tagID}
+	}
+
+	return tx.Create(&joins).Error
+}
+
+func upsertImageReference(tx *gorm.DB, imageID int, name, tag string) error {
+	var ref imageReferenceDomain.ImageReference
+	err := tx.Where("name = ? AND tag = ?", name, tag).First(&ref).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ref = imageReferenceDomain.ImageReference{ImageID: imageID, Name: name, Tag: tag}
+		return tx.Create(&ref).Error
+	case err != nil:
+		return err
+	default:
+		ref.ImageID = imageID
+		return tx.Save(&ref).Error
+	}
+}
+
+func (r *imageBundleRepository) Export(ctx context.Context, query *imageBundleDomain.ExportQuery) (*imageDomain.ListImages, error) {
+	base := r.dbClient.Conn(ctx).Where("deleted_at IS NULL")
+
+	if len(query.ImageIDs) > 0 || len(query.TagNames) > 0 {
+		subQuery := r.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_tags.image_id").
+			Joins("JOIN tags ON tags.id = image_tags.tag_id").
+			Where("tags.name IN ?", query.TagNames)
+		base = base.Where("id IN (?) OR id IN (?)", query.ImageIDs, subQuery)
+	}
+
+	var images imageDomain.ListImages
+	if err := base.Preload("Tags").Find(&images).Error; err != nil {
+		return nil, err
+	}
+
+	return &images, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	imageReferenceDomain "github.com/keito-isurugi/kei-talk/domain/image_reference"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+
+	"gorm.io/gorm"
+)
+
+type imageReferenceRepository struct {
+	dbClient db.Client
+}
+
+func NewImageReferenceRepository(dbClient db.Client) imageReferenceDomain.ImageReferenceRepository {
+	return &imageReferenceRepository{
+		dbClient: dbClient,
+	}
+}
+
+func (r *imageReferenceRepository) Tag(ctx context.Context, imageID int, name, tag string) error {
+	var ref imageReferenceDomain.ImageReference
+	err := r.dbClient.Conn(ctx).Where("name = ? AND tag = ?", name, tag).First(&ref).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ref = imageReferenceDomain.ImageReference{
+			ImageID: imageID,
+			Name:    name,
+			Tag:     tag,
+		}
+		return r.dbClient.Conn(ctx).Create(&ref).Error
+	case err != nil:
+		return err
+	default:
+		ref.ImageID = imageID
+		return r.dbClient.Conn(ctx).Save(&ref).Error
+	}
+}
+
+func (r *imageReferenceRepository) Untag(ctx context.Context, name, tag string) error {
+	var ref imageReferenceDomain.ImageReference
+	if err := r.dbClient.Conn(ctx).Where("name = ? AND tag = ?", name, tag).First(&ref).Error; err != nil {
+		return err
+	}
+
+	return r.dbClient.Conn(ctx).Delete(&ref).Error
+}
+
+func (r *imageReferenceRepository) Resolve(ctx context.Context, name, tag string) (*imageReferenceDomain.ImageReference, error) {
+	var ref imageReferenceDomain.ImageReference
+	if err := r.dbClient.Conn(ctx).Where("name = ? AND tag = ?", name, tag).First(&ref).Error; err != nil {
+		return nil, err
+	}
+
+	return &ref, nil
+}
+
+func (r *imageReferenceRepository) ListByImageID(ctx context.Context, imageID int) ([]imageReferenceDomain.ImageReference, error) {
+	var refs []imageReferenceDomain.ImageReference
+	if err := r.dbClient.Conn(ctx).Where("image_id = ?", imageID).Find(&refs).Error; err != nil {
+		return nil, err
+	}
+
+	return refs, nil
+}
@@ -2,12 +2,18 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	imageDomain "github.com/keito-isurugi/various-app/domain/image"
-	imageTagDomain "github.com/keito-isurugi/various-app/domain/image_tag"
+	"strings"
+	"time"
 
-	"github.com/keito-isurugi/various-app/domain/image"
-	"github.com/keito-isurugi/various-app/infrastructure/postgresql"
+	imageDomain "github.com/keito-isurugi/kei-talk/domain/image"
+	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
+
+	"gorm.io/gorm"
+
+	"github.com/keito-isurugi/kei-talk/domain/image"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
 )
 
 type imageRepository struct {
@@ -33,6 +39,121 @@ func (ir *imageRepository) ListImages(ctx context.Context) (*image.ListImages, e
 	return &lt, nil
 }
 
+func (ir *imageRepository) FindImageByDigest(ctx context.Context, digest string) (*image.Image, bool, error) {
+	var img image.Image
+	err := ir.dbClient.Conn(ctx).Where("digest = ?", digest).First(&img).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return &img, true, nil
+}
+
+func (ir *imageRepository) FindImageByPath(ctx context.Context, imagePath string) (*image.Image, bool, error) {
+	var img image.Image
+	err := ir.dbClient.Conn(ctx).Where("image_path = ?", imagePath).First(&img).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return &img, true, nil
+}
+
+func (ir *imageRepository) CommitImageUpload(ctx context.Context, imageID int, etag string, sizeBytes int64) error {
+	return ir.dbClient.Conn(ctx).Model(&image.Image{}).
+		Where("id = ?", imageID).
+		Updates(map[string]interface{}{
+			"status":       imageDomain.ImageStatusCommitted,
+			"display_flag": true,
+			"etag":         etag,
+			"size_bytes":   sizeBytes,
+		}).Error
+}
+
+func (ir *imageRepository) FindImage(ctx context.Context, id int) (*image.Image, bool, error) {
+	var img image.Image
+	err := ir.dbClient.Conn(ctx).Where("id = ?", id).First(&img).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return &img, true, nil
+}
+
+func (ir *imageRepository) ListTagNamesByImageIDs(ctx context.Context, imageIDs []int) (map[int][]string, error) {
+	if len(imageIDs) == 0 {
+		return map[int][]string{}, nil
+	}
+
+	var rows []struct {
+		ImageID int
+		Name    string
+	}
+	if err := ir.dbClient.Conn(ctx).
+		Model(&imageTagDomain.ImageTag{}).
+		Select("image_tags.image_id, tags.name").
+		Joins("JOIN tags ON tags.id = image_tags.tag_id").
+		Where("image_tags.image_id IN ?", imageIDs).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	tagNamesByImageID := make(map[int][]string, len(imageIDs))
+	for _, row := range rows {
+		tagNamesByImageID[row.ImageID] = append(tagNamesByImageID[row.ImageID], row.Name)
+	}
+
+	return tagNamesByImageID, nil
+}
+
+func (ir *imageRepository) ListImagesPaged(ctx context.Context, query *imageDomain.ListImagesQuery) (*image.ListImages, error) {
+	base := ir.dbClient.Conn(ctx).Model(&image.Image{})
+
+	if !query.IncludeDeleted {
+		base = base.Where("deleted_at IS NULL")
+	}
+	if query.DisplayFlag != nil {
+		base = base.Where("display_flag = ?", *query.DisplayFlag)
+	}
+	if query.CreatedAfter != nil {
+		base = base.Where("created_at >= ?", *query.CreatedAfter)
+	}
+	if query.CreatedBefore != nil {
+		base = base.Where("created_at <= ?", *query.CreatedBefore)
+	}
+	if query.Q != "" {
+		base = base.Where("image_path LIKE ?", "%"+query.Q+"%")
+	}
+	if query.CursorCreatedAt != nil {
+		base = base.Where("(created_at, id) > (?, ?)", *query.CursorCreatedAt, query.CursorID)
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var images image.ListImages
+	if err := base.
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Preload("Tags").
+		Find(&images).Error; err != nil {
+		return nil, err
+	}
+
+	return &images, nil
+}
+
 func (ir *imageRepository) GetImage(ctx context.Context, id int) (*image.Image, error) {
 	var img image.Image
 	if err := ir.dbClient.Conn(ctx).
@@ -85,43 +206,246 @@ func (ir *imageRepository) DeleteImage(ctx context.Context, path string) error {
 	return nil
 }
 
+// GetUntaggedImagesByTags returns images that are NOT tagged with every one
+// of tagIDs.TagIDs, i.e. Not{All{tagIDs.TagIDs}} with no pagination.
 func (ir *imageRepository) GetUntaggedImagesByTags(ctx context.Context, tagIDs *imageDomain.ListImagesNoTaggedTags) (*image.ListImages, error) {
-	var untaggedImages image.ListImages
+	images, _, err := ir.Search(ctx, imageDomain.Not{Inner: imageDomain.All{TagIDs: tagIDs.TagIDs}}, imageDomain.Pagination{}, true)
+	if err != nil {
+		return nil, err
+	}
 
-	// サブクエリ: すべての tagIDs に紐づいている image_id を計算
-	subQuery := ir.dbClient.Conn(ctx).
-		Model(&imageTagDomain.ImageTag{}).
-		Select("image_id").
-		Where("tag_id IN ?", tagIDs.TagIDs).
-		Group("image_id").
-		Having("COUNT(DISTINCT tag_id) = ?", len(tagIDs.TagIDs)) // 指定されたすべてのタグに紐づいている image_id
+	return images, nil
+}
 
-	// メインクエリ: 上記サブクエリに含まれない画像を取得し、Tags を Preload でロード
-	if err := ir.dbClient.Conn(ctx).
-		Where("id NOT IN (?)", subQuery).
-		Preload("Tags"). // Tags情報をロード
-		Find(&untaggedImages).Error; err != nil {
-		return nil, err
+func (ir *imageRepository) SearchImages(ctx context.Context, query *imageDomain.SearchImagesQuery) (*image.ListImages, int64, error) {
+	var images image.ListImages
+
+	base := ir.dbClient.Conn(ctx).Model(&image.Image{})
+
+	switch query.Match {
+	case "all":
+		subQuery := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Where("tag_id IN ?", query.TagIDs).
+			Group("image_id").
+			Having("COUNT(DISTINCT tag_id) = ?", len(query.TagIDs))
+		base = base.Where("id IN (?)", subQuery)
+	case "none":
+		subQuery := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Where("tag_id IN ?", query.TagIDs)
+		base = base.Where("id NOT IN (?)", subQuery)
+	default: // "any"
+		subQuery := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Where("tag_id IN ?", query.TagIDs)
+		base = base.Where("id IN (?)", subQuery)
+	}
+
+	var totalCount int64
+	if err := base.Session(&gorm.Session{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if query.Cursor > 0 {
+		base = base.Where("id > ?", query.Cursor)
 	}
 
-	return &untaggedImages, nil
+	limit := query.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	if err := base.
+		Order("id ASC").
+		Limit(limit).
+		Preload("Tags").
+		Find(&images).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &images, totalCount, nil
 }
 
+// GetTaggedImagesByTags returns images tagged with at least one of tagIDs,
+// i.e. Any{tagIDs} with no pagination.
 func (ir *imageRepository) GetTaggedImagesByTags(ctx context.Context, tagIDs []int) (*image.ListImages, error) {
-	var taggedImages image.ListImages
+	images, _, err := ir.Search(ctx, imageDomain.Any{TagIDs: tagIDs}, imageDomain.Pagination{}, false)
+	if err != nil {
+		return nil, err
+	}
 
-	// サブクエリを作成して、指定された tagIDs に紐づく image_id を取得
-	subQuery := ir.dbClient.Conn(ctx).
-		Model(&imageTagDomain.ImageTag{}).
-		Select("image_id").
-		Where("tag_id IN ?", tagIDs)
+	return images, nil
+}
 
-	// images テーブルで、そのような image_id を持つレコードを取得
-	if err := ir.dbClient.Conn(ctx).
-		Where("id IN (?)", subQuery).
-		Find(&taggedImages).Error; err != nil {
+// Search compiles query to a single SQL statement via applyTagQuery and
+// returns the matching page of images.
+func (ir *imageRepository) Search(ctx context.Context, query imageDomain.TagQuery, page imageDomain.Pagination, preloadTags bool) (*image.ListImages, int64, error) {
+	base := ir.applyTagQuery(ctx, ir.dbClient.Conn(ctx).Model(&image.Image{}), query)
+
+	var totalCount int64
+	if err := base.Session(&gorm.Session{}).Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	q := base.Session(&gorm.Session{}).Order("created_at DESC, id DESC")
+	if page.Limit > 0 {
+		q = q.Limit(page.Limit)
+	}
+	if page.Offset > 0 {
+		q = q.Offset(page.Offset)
+	}
+	if preloadTags {
+		q = q.Preload("Tags")
+	}
+
+	var images image.ListImages
+	if err := q.Find(&images).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &images, totalCount, nil
+}
+
+// applyTagQuery adds query's condition(s) onto base (either the top-level
+// images query, or a nested id-only subquery built by imageIDSubquery) and
+// returns the resulting *gorm.DB.
+func (ir *imageRepository) applyTagQuery(ctx context.Context, base *gorm.DB, query imageDomain.TagQuery) *gorm.DB {
+	switch q := query.(type) {
+	case imageDomain.Any:
+		sub := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Where("tag_id IN ?", q.TagIDs)
+		return base.Where("id IN (?)", sub)
+	case imageDomain.All:
+		sub := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Where("tag_id IN ?", q.TagIDs).
+			Group("image_id").
+			Having("COUNT(DISTINCT tag_id) = ?", len(q.TagIDs))
+		return base.Where("id IN (?)", sub)
+	case imageDomain.Not:
+		return base.Where("id NOT IN (?)", ir.imageIDSubquery(ctx, q.Inner))
+	case imageDomain.And:
+		for _, clause := range q.Clauses {
+			base = ir.applyTagQuery(ctx, base, clause)
+		}
+		return base
+	case imageDomain.Or:
+		// Build the OR'd clauses on their own fresh *gorm.DB and nest that
+		// whole expression as a single Where argument on base, so GORM
+		// parenthesizes the group. Chaining .Or directly onto base would
+		// instead flatten it into base's existing (sibling, e.g. And'd)
+		// conditions with no parens, changing the query's meaning.
+		group := ir.dbClient.Conn(ctx)
+		for i, clause := range q.Clauses {
+			sub := ir.imageIDSubquery(ctx, clause)
+			if i == 0 {
+				group = group.Where("id IN (?)", sub)
+			} else {
+				group = group.Or("id IN (?)", sub)
+			}
+		}
+		return base.Where(group)
+	case imageDomain.HasCount:
+		var op string
+		switch q.Op {
+		case imageDomain.TagQueryOpGE:
+			op = ">="
+		case imageDomain.TagQueryOpLE:
+			op = "<="
+		default:
+			op = "="
+		}
+		// A correlated count, so images with no image_tags rows count as 0.
+		return base.Where("(SELECT COUNT(DISTINCT image_tags.tag_id) FROM image_tags WHERE image_tags.image_id = images.id) "+op+" ?", q.N)
+	default:
+		return base
+	}
+}
+
+// imageIDSubquery compiles query into a standalone "SELECT id FROM images
+// WHERE ..." query, for use as a nested subquery by Not and Or.
+func (ir *imageRepository) imageIDSubquery(ctx context.Context, query imageDomain.TagQuery) *gorm.DB {
+	return ir.applyTagQuery(ctx, ir.dbClient.Conn(ctx).Model(&image.Image{}).Select("id"), query)
+}
+
+func (ir *imageRepository) PruneImages(ctx context.Context, query *imageDomain.PruneImagesQuery) ([]image.Image, error) {
+	if !query.All && len(query.Filters["label"]) > 0 {
+		// Without All, the default candidate set is already restricted to
+		// images with zero image_tags rows, which a label filter (a tags
+		// match) can never satisfy — reject the combination instead of
+		// silently returning an empty report every time.
+		return nil, fmt.Errorf("label filter requires All: no untagged image can match a label")
+	}
+
+	base := ir.dbClient.Conn(ctx).Model(&image.Image{})
+
+	if !query.All {
+		taggedImageIDs := ir.dbClient.Conn(ctx).
+			Model(&imageTagDomain.ImageTag{}).
+			Select("image_id").
+			Group("image_id")
+		base = base.Where("id NOT IN (?)", taggedImageIDs)
+	}
+
+	for key, values := range query.Filters {
+		if len(values) == 0 {
+			continue
+		}
+		switch key {
+		case "display_flag":
+			base = base.Where("display_flag = ?", values[0] == "true")
+		case "before":
+			before, err := time.Parse(time.RFC3339, values[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid before filter %q: %w", values[0], err)
+			}
+			base = base.Where("created_at < ?", before)
+		case "untagged", "dangling":
+			// Already the default candidate set above; no extra clause needed.
+		case "label":
+			// Each value is "namespace" or "namespace=variant" (the closest
+			// equivalent this domain has to `docker image prune
+			// --filter label=...`, since tags rather than OCI labels carry
+			// an image's namespace/variant, see reference.TagReference).
+			// Multiple values are OR'd together, like docker's own filters.
+			group := ir.dbClient.Conn(ctx)
+			for i, v := range values {
+				namespace, variant, hasVariant := strings.Cut(v, "=")
+				cond := "tags.namespace = ?"
+				args := []interface{}{namespace}
+				if hasVariant {
+					cond += " AND tags.variant = ?"
+					args = append(args, variant)
+				}
+				if i == 0 {
+					group = group.Where(cond, args...)
+				} else {
+					group = group.Or(cond, args...)
+				}
+			}
+
+			sub := ir.dbClient.Conn(ctx).
+				Model(&imageTagDomain.ImageTag{}).
+				Select("image_tags.image_id").
+				Joins("JOIN tags ON tags.id = image_tags.tag_id").
+				Where(group)
+			base = base.Where("id IN (?)", sub)
+		default:
+			return nil, fmt.Errorf("unsupported prune filter %q", key)
+		}
+	}
+
+	var candidates []image.Image
+	if err := base.Find(&candidates).Error; err != nil {
 		return nil, err
 	}
 
-	return &taggedImages, nil
+	return candidates, nil
 }
@@ -6,6 +6,7 @@ import (
 	imageTagDomain "github.com/keito-isurugi/kei-talk/domain/image_tag"
 	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
 	"gorm.io/gorm"
+	"time"
 )
 
 type imageTagRepository struct {
@@ -20,7 +21,7 @@ func NewImageTagRepository(dbClient db.Client) imageTagDomain.ImageTagRepository
 
 func (r *imageTagRepository) ListImageTags(ctx context.Context) (*imageTagDomain.ListImageTags, error) {
 	var it imageTagDomain.ListImageTags
-	if err := r.dbClient.Conn(ctx).Find(&it).Error; err != nil {
+	if err := r.dbClient.Conn(ctx).Where("deleted_at IS NULL").Find(&it).Error; err != nil {
 		return nil, err
 	}
 
@@ -32,6 +33,7 @@ func (r *imageTagRepository) GetImageTag(ctx context.Context, id int) (*imageTag
 	var it imageTagDomain.ImageTag
 	if err := r.dbClient.Conn(ctx).
 		Where("id", id).
+		Where("deleted_at IS NULL").
 		First(&it).Error; err != nil {
 		return nil, err
 	}
@@ -88,6 +90,82 @@ func (r *imageTagRepository) RegisterMultipleImageTags(ctx context.Context, ids
 	return insertedIDs, nil
 }
 
+// replaceImageTagsBatchSize bounds how many rows CreateInBatches inserts per
+// statement when replacing an image's tag set.
+const replaceImageTagsBatchSize = 100
+
+func (r *imageTagRepository) ReplaceImageTags(ctx context.Context, imageID int, tagIDs []int) error {
+	return r.dbClient.Conn(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []imageTagDomain.ImageTag
+		if err := tx.Where("image_id = ?", imageID).Where("deleted_at IS NULL").Find(&existing).Error; err != nil {
+			return err
+		}
+
+		existingTagIDs := make(map[int]bool, len(existing))
+		for _, it := range existing {
+			existingTagIDs[it.TagID] = true
+		}
+
+		wantTagIDs := make(map[int]bool, len(tagIDs))
+		for _, tagID := range tagIDs {
+			wantTagIDs[tagID] = true
+		}
+
+		var toInsert []imageTagDomain.ImageTag
+		for tagID := range wantTagIDs {
+			if !existingTagIDs[tagID] {
+				toInsert = append(toInsert, imageTagDomain.ImageTag{
+					ImageID: imageID,
+					TagID:   tagID,
+				})
+			}
+		}
+
+		var toDeleteTagIDs []int
+		for _, it := range existing {
+			if !wantTagIDs[it.TagID] {
+				toDeleteTagIDs = append(toDeleteTagIDs, it.TagID)
+			}
+		}
+
+		if len(toInsert) > 0 {
+			if err := tx.CreateInBatches(&toInsert, replaceImageTagsBatchSize).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(toDeleteTagIDs) > 0 {
+			// ImageTag.DeletedAt is a plain *time.Time, not gorm.DeletedAt, so
+			// GORM's soft-delete hook never fires here; set it explicitly
+			// instead of calling Delete, which would hard-delete the rows.
+			if err := tx.Model(&imageTagDomain.ImageTag{}).
+				Where("image_id = ?", imageID).
+				Where("tag_id IN ?", toDeleteTagIDs).
+				Update("deleted_at", time.Now()).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *imageTagRepository) UntagImage(ctx context.Context, imageID, tagID int) (bool, error) {
+	// ImageTag.DeletedAt is a plain *time.Time, not gorm.DeletedAt, so GORM's
+	// soft-delete hook never fires here; set it explicitly instead of
+	// calling Delete, which would hard-delete the row.
+	result := r.dbClient.Conn(ctx).
+		Model(&imageTagDomain.ImageTag{}).
+		Where("image_id = ? AND tag_id = ?", imageID, tagID).
+		Where("deleted_at IS NULL").
+		Update("deleted_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 func (r *imageTagRepository) DeleteMultipleImageTags(ctx context.Context, ids *imageTagDomain.UpdateMultipleImageTags) error {
 	if err := r.dbClient.Conn(ctx).Transaction(func(tx *gorm.DB) error {
 		// 複数条件で削除を実行
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	"github.com/keito-isurugi/kei-talk/domain/tag"
 	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+
+	"gorm.io/gorm"
 )
 
 type tagRepository struct {
@@ -77,3 +80,52 @@ func (r *tagRepository) DeleteTag(ctx context.Context, id int) error {
 
 	return nil
 }
+
+func (r *tagRepository) FindTagByName(ctx context.Context, name string) (*tag.Tag, bool, error) {
+	var t tag.Tag
+	err := r.dbClient.Conn(ctx).Where("name = ?", name).First(&t).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return &t, true, nil
+}
+
+func (r *tagRepository) FindTagsByNames(ctx context.Context, names []string) (map[string]int, error) {
+	if len(names) == 0 {
+		return map[string]int{}, nil
+	}
+
+	var tags []tag.Tag
+	if err := r.dbClient.Conn(ctx).Where("name IN ?", names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	idsByName := make(map[string]int, len(tags))
+	for _, t := range tags {
+		idsByName[t.Name] = t.ID
+	}
+
+	return idsByName, nil
+}
+
+func (r *tagRepository) ListTagsByNamespace(ctx context.Context, namespace string) (*tag.ListTags, error) {
+	var tl tag.ListTags
+	if err := r.dbClient.Conn(ctx).Where("namespace = ?", namespace).Find(&tl).Error; err != nil {
+		return nil, err
+	}
+
+	return &tl, nil
+}
+
+func (r *tagRepository) ListTagsByVariant(ctx context.Context, variant string) (*tag.ListTags, error) {
+	var tl tag.ListTags
+	if err := r.dbClient.Conn(ctx).Where("variant = ?", variant).Find(&tl).Error; err != nil {
+		return nil, err
+	}
+
+	return &tl, nil
+}
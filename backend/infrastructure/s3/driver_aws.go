@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	domain "github.com/keito-isurugi/kei-talk/domain/storage"
+)
+
+// awsDriver implements domain.Driver against aws-sdk-go v1, the original
+// (and still default) backend.
+type awsDriver struct {
+	s3Client s3iface.S3API
+}
+
+func newAWSDriver(s3Client s3iface.S3API) domain.Driver {
+	return &awsDriver{s3Client: s3Client}
+}
+
+func (d *awsDriver) Put(ctx context.Context, bucketName, objectKey, contentType string, body io.ReadSeeker) (*domain.PutResult, error) {
+	out, err := d.s3Client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objectKey),
+		Body:        body,
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %v", err)
+	}
+
+	size, err := d.statSize(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PutResult{
+		URL:         fmt.Sprintf("%s/%s", bucketName, objectKey),
+		ETag:        aws.StringValue(out.ETag),
+		Size:        size,
+		ContentType: contentType,
+	}, nil
+}
+
+func (d *awsDriver) Delete(ctx context.Context, bucketName, objectKey string) error {
+	_, err := d.s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	return err
+}
+
+func (d *awsDriver) Stat(ctx context.Context, bucketName, objectKey string) (*domain.StatResult, error) {
+	out, err := d.s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound") {
+			return nil, domain.ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head object: %v", err)
+	}
+
+	return &domain.StatResult{
+		Size:        aws.Int64Value(out.ContentLength),
+		ETag:        aws.StringValue(out.ETag),
+		ContentType: aws.StringValue(out.ContentType),
+	}, nil
+}
+
+func (d *awsDriver) PresignGet(ctx context.Context, bucketName, objectKey string, expires time.Duration) (string, error) {
+	req, _ := d.s3Client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	req.SetContext(ctx)
+
+	return req.Presign(expires)
+}
+
+func (d *awsDriver) PresignPut(ctx context.Context, bucketName, objectKey, contentType string, expires time.Duration) (string, http.Header, error) {
+	req, _ := d.s3Client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(objectKey),
+		ContentType: aws.String(contentType),
+	})
+	req.SetContext(ctx)
+
+	url, err := req.Presign(expires)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Content-Type is part of what was signed, so the client must send the
+	// exact same header on its PUT or the signature won't validate.
+	return url, req.HTTPRequest.Header, nil
+}
+
+func (d *awsDriver) List(ctx context.Context, bucketName, prefix string) ([]string, error) {
+	out, err := d.s3Client.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects: %v", err)
+	}
+
+	keys := make([]string, len(out.Contents))
+	for i, obj := range out.Contents {
+		keys[i] = aws.StringValue(obj.Key)
+	}
+
+	return keys, nil
+}
+
+func (d *awsDriver) statSize(ctx context.Context, bucketName, objectKey string) (int64, error) {
+	stat, err := d.Stat(ctx, bucketName, objectKey)
+	if err != nil {
+		return 0, err
+	}
+	return stat.Size, nil
+}
@@ -1,36 +1,59 @@
 package repository
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"mime/multipart"
 	"net/http"
-	"strings"
+	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 
-	domain "github.com/keito-isurugi/various-app/domain/storage"
-	"github.com/keito-isurugi/various-app/infrastructure/env"
+	domain "github.com/keito-isurugi/kei-talk/domain/storage"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
+	"github.com/keito-isurugi/kei-talk/infrastructure/miniogo"
 )
 
+// s3Repository implements domain.StorageRepository by composing over a
+// domain.Driver, so the rest of the app stays agnostic to which storage
+// backend env.Values.StorageBackend selected.
 type s3Repository struct {
-	ev       *env.Values
-	S3Client s3iface.S3API
+	ev     *env.Values
+	driver domain.Driver
 }
 
-func NewS3Repository(ev *env.Values, s3 s3iface.S3API) domain.StorageRepository {
+// NewS3Repository is a thin factory dispatching on ev.StorageBackend:
+// "minio" builds a MinIO-backed driver from ev.Storage, anything else (the
+// default) wraps the already-constructed aws-sdk-go client s3Client.
+func NewS3Repository(ev *env.Values, s3Client s3iface.S3API) domain.StorageRepository {
+	var driver domain.Driver
+	switch ev.StorageBackend {
+	case "minio":
+		minioDriver, err := miniogo.NewDriver(ev)
+		if err != nil {
+			// Fall back to the AWS driver rather than returning a nil
+			// Driver; the first call will surface the real connectivity
+			// error instead of a misconfiguration one.
+			driver = newAWSDriver(s3Client)
+			break
+		}
+		driver = minioDriver
+	default:
+		driver = newAWSDriver(s3Client)
+	}
+
 	return &s3Repository{
-		ev:       ev,
-		S3Client: s3,
+		ev:     ev,
+		driver: driver,
 	}
 }
 
-func (s3r *s3Repository) PutObject(file *multipart.FileHeader, bucketName, objectKey string) (string, error) {
+func (s3r *s3Repository) PutObject(ctx context.Context, file *multipart.FileHeader, bucketName, objectKey string) (*domain.PutResult, error) {
 	// ファイルを開く
 	src, err := file.Open()
 	if err != nil {
-		return "", fmt.Errorf("failed to open file: %v", err)
+		return nil, fmt.Errorf("failed to open file: %v", err)
 	}
 	defer src.Close()
 
@@ -41,49 +64,79 @@ func (s3r *s3Repository) PutObject(file *multipart.FileHeader, bucketName, objec
 		buffer := make([]byte, 512)
 		_, err = src.Read(buffer)
 		if err != nil {
-			return "", fmt.Errorf("failed to read file content: %v", err)
+			return nil, fmt.Errorf("failed to read file content: %v", err)
 		}
 		contentType = http.DetectContentType(buffer)
 
 		// ファイルポインタを先頭に戻す
 		_, err = src.Seek(0, 0)
 		if err != nil {
-			return "", fmt.Errorf("failed to reset file pointer: %v", err)
+			return nil, fmt.Errorf("failed to reset file pointer: %v", err)
 		}
 	}
 
-	// PutObjectInput構造体を作成
-	input := &s3.PutObjectInput{
-		Bucket:      aws.String(bucketName),
-		Key:         aws.String(objectKey),
-		Body:        src,
-		ContentType: aws.String(contentType),
+	result, err := s3r.driver.Put(ctx, bucketName, objectKey, contentType, src)
+	if err != nil {
+		return nil, err
 	}
 
-	// S3にアップロード
-	_, err = s3r.S3Client.PutObject(input)
+	// アップロードされたオブジェクトのURLを生成
+	result.URL = fmt.Sprintf("%s/%s/%s", s3r.ev.AwsS3Endpoint, bucketName, objectKey)
+
+	return result, nil
+}
+
+func (s3r *s3Repository) PresignPutObject(ctx context.Context, bucketName, objectKey, contentType string, expires time.Duration) (string, http.Header, error) {
+	url, headers, err := s3r.driver.PresignPut(ctx, bucketName, objectKey, contentType, expires)
 	if err != nil {
-		return "", fmt.Errorf("failed to upload file: %v", err)
+		return "", nil, fmt.Errorf("failed to presign put object: %v", err)
 	}
 
-	// アップロードされたオブジェクトのURLを生成
-	url := fmt.Sprintf("%s/%s/%s", s3r.ev.AwsS3Endpoint, bucketName, objectKey)
+	return url, headers, nil
+}
+
+func (s3r *s3Repository) PresignGetObject(ctx context.Context, bucketName, objectKey string, expires time.Duration) (string, error) {
+	url, err := s3r.driver.PresignGet(ctx, bucketName, objectKey, expires)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign get object: %v", err)
+	}
 
 	return url, nil
 }
 
-func (s3r *s3Repository) DeleteObject(attachmentFile string) error {
-	key := strings.Split(attachmentFile, s3r.ev.AwsS3BucketName+"/")
+func (s3r *s3Repository) HeadObject(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	_, err := s3r.driver.Stat(ctx, bucketName, objectKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
 
-	input := &s3.DeleteObjectInput{
-		Bucket: aws.String(s3r.ev.AwsS3BucketName),
-		Key:    aws.String(key[1]),
+func (s3r *s3Repository) StatObjectSize(ctx context.Context, bucketName, objectKey string) (int64, error) {
+	stat, err := s3r.driver.Stat(ctx, bucketName, objectKey)
+	if err != nil {
+		return 0, err
 	}
 
-	_, err := s3r.S3Client.DeleteObject(input)
+	return stat.Size, nil
+}
+
+func (s3r *s3Repository) StatObject(ctx context.Context, bucketName, objectKey string) (string, int64, bool, error) {
+	stat, err := s3r.driver.Stat(ctx, bucketName, objectKey)
 	if err != nil {
-		return err
+		if errors.Is(err, domain.ErrObjectNotFound) {
+			return "", 0, false, nil
+		}
+		return "", 0, false, err
 	}
 
-	return nil
+	return stat.ETag, stat.Size, true, nil
+}
+
+func (s3r *s3Repository) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	return s3r.driver.Delete(ctx, bucketName, objectKey)
 }
@@ -1,17 +1,28 @@
 package image
 
 import (
+	"archive/tar"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
-	"github.com/google/uuid"
 	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	imageBundleApp "github.com/keito-isurugi/kei-talk/application/image_bundle"
+	imageReferenceApp "github.com/keito-isurugi/kei-talk/application/image_reference"
+	imageTagApp "github.com/keito-isurugi/kei-talk/application/image_tag"
+	imageBundleDomain "github.com/keito-isurugi/kei-talk/domain/image_bundle"
 	"github.com/keito-isurugi/kei-talk/infrastructure/env"
 	"github.com/labstack/echo/v4"
 )
@@ -22,15 +33,37 @@ type ImageHandler interface {
 	DeleteImage(c echo.Context) error
 	RegisterImage(c echo.Context) error
 	RegisterImages(c echo.Context) error
+	PresignImageUpload(c echo.Context) error
+	ConfirmImageUpload(c echo.Context) error
+	SearchImages(c echo.Context) error
+	ReplaceImageTags(c echo.Context) error
+	TagImage(c echo.Context) error
+	UntagImage(c echo.Context) error
+	ResolveReference(c echo.Context) error
+	ImportImages(c echo.Context) error
+	ExportImages(c echo.Context) error
+	GetImageHistory(c echo.Context) error
+	DeriveImage(c echo.Context) error
 }
 
 type imageHandler struct {
-	ev                   *env.Values
-	awsClient            s3iface.S3API
-	listImagesUseCase    imageApp.ListImagesUseCase
-	getImageUseCase      imageApp.GetImageUseCase
-	deleteImageUseCase   imageApp.DeleteImageUseCase
-	registerImageUseCase imageApp.RegisterImageUseCase
+	ev                        *env.Values
+	awsClient                 s3iface.S3API
+	listImagesUseCase         imageApp.ListImagesUseCase
+	getImageUseCase           imageApp.GetImageUseCase
+	deleteImageUseCase        imageApp.DeleteImageUseCase
+	registerImageUseCase      imageApp.RegisterImageUseCase
+	findImageByDigestUseCase  imageApp.FindImageByDigestUseCase
+	requestImageUploadUseCase imageApp.RequestImageUploadUseCase
+	confirmImageUploadUseCase imageApp.ConfirmImageUploadUseCase
+	searchImagesUseCase       imageApp.SearchImagesUseCase
+	replaceImageTagsUseCase   imageTagApp.ReplaceImageTagsUseCase
+	tagImageUseCase           imageReferenceApp.TagImageUseCase
+	untagImageUseCase         imageReferenceApp.UntagImageUseCase
+	resolveReferenceUseCase   imageReferenceApp.ResolveReferenceUseCase
+	importImagesUseCase       imageBundleApp.ImportImagesUseCase
+	exportImagesUseCase       imageBundleApp.ExportImagesUseCase
+	getImageHistoryUseCase    imageApp.GetImageHistoryUseCase
 }
 
 func NewImageHandler(
@@ -40,29 +73,141 @@ func NewImageHandler(
 	getImageUseCase imageApp.GetImageUseCase,
 	deleteImageUseCase imageApp.DeleteImageUseCase,
 	registerImageUseCase imageApp.RegisterImageUseCase,
+	findImageByDigestUseCase imageApp.FindImageByDigestUseCase,
+	requestImageUploadUseCase imageApp.RequestImageUploadUseCase,
+	confirmImageUploadUseCase imageApp.ConfirmImageUploadUseCase,
+	searchImagesUseCase imageApp.SearchImagesUseCase,
+	replaceImageTagsUseCase imageTagApp.ReplaceImageTagsUseCase,
+	tagImageUseCase imageReferenceApp.TagImageUseCase,
+	untagImageUseCase imageReferenceApp.UntagImageUseCase,
+	resolveReferenceUseCase imageReferenceApp.ResolveReferenceUseCase,
+	importImagesUseCase imageBundleApp.ImportImagesUseCase,
+	exportImagesUseCase imageBundleApp.ExportImagesUseCase,
+	getImageHistoryUseCase imageApp.GetImageHistoryUseCase,
 ) ImageHandler {
 	return &imageHandler{
-		ev:                   ev,
-		awsClient:            awsClient,
-		listImagesUseCase:    listImagesUseCase,
-		getImageUseCase:      getImageUseCase,
-		deleteImageUseCase:   deleteImageUseCase,
-		registerImageUseCase: registerImageUseCase,
+		ev:                        ev,
+		awsClient:                 awsClient,
+		listImagesUseCase:         listImagesUseCase,
+		getImageUseCase:           getImageUseCase,
+		deleteImageUseCase:        deleteImageUseCase,
+		registerImageUseCase:      registerImageUseCase,
+		findImageByDigestUseCase:  findImageByDigestUseCase,
+		requestImageUploadUseCase: requestImageUploadUseCase,
+		confirmImageUploadUseCase: confirmImageUploadUseCase,
+		searchImagesUseCase:       searchImagesUseCase,
+		replaceImageTagsUseCase:   replaceImageTagsUseCase,
+		tagImageUseCase:           tagImageUseCase,
+		untagImageUseCase:         untagImageUseCase,
+		resolveReferenceUseCase:   resolveReferenceUseCase,
+		importImagesUseCase:       importImagesUseCase,
+		exportImagesUseCase:       exportImagesUseCase,
+		getImageHistoryUseCase:    getImageHistoryUseCase,
 	}
 }
 
+// listImagesCursorSep separates the created_at and id components of the
+// opaque keyset cursor returned from / accepted by GET /api/images.
+const listImagesCursorSep = "_"
+
+func decodeListImagesCursor(cursor string) (*time.Time, int, error) {
+	if cursor == "" {
+		return nil, 0, nil
+	}
+
+	parts := strings.SplitN(cursor, listImagesCursorSep, 2)
+	if len(parts) != 2 {
+		return nil, 0, fmt.Errorf("invalid cursor: %s", cursor)
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid cursor: %s", cursor)
+	}
+
+	id, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid cursor: %s", cursor)
+	}
+
+	return &createdAt, id, nil
+}
+
+func encodeListImagesCursor(createdAt *time.Time, id int) string {
+	if createdAt == nil {
+		return ""
+	}
+	return createdAt.Format(time.RFC3339Nano) + listImagesCursorSep + strconv.Itoa(id)
+}
+
+// ListImages returns a keyset-paginated, filterable page of images and sets
+// an ETag header (the max updated_at across the page) so clients can
+// conditionally re-fetch with If-None-Match.
 func (ih *imageHandler) ListImages(c echo.Context) error {
-	li, err := ih.listImagesUseCase.Exec(c)
+	var req listImagesRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	cursorCreatedAt, cursorID, err := decodeListImagesCursor(req.Cursor)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	input := imageApp.ListImagesInputDto{
+		Limit:           req.Limit,
+		CursorCreatedAt: cursorCreatedAt,
+		CursorID:        cursorID,
+		DisplayFlag:     req.DisplayFlag,
+		Q:               req.Q,
+		IncludeDeleted:  req.IncludeDeleted,
+	}
+
+	if req.CreatedAfter != "" {
+		createdAfter, err := time.Parse(time.RFC3339, req.CreatedAfter)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid created_after"})
+		}
+		input.CreatedAfter = &createdAfter
+	}
+	if req.CreatedBefore != "" {
+		createdBefore, err := time.Parse(time.RFC3339, req.CreatedBefore)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid created_before"})
+		}
+		input.CreatedBefore = &createdBefore
+	}
+
+	out, err := ih.listImagesUseCase.Exec(c.Request().Context(), input)
 	if err != nil {
 		return err
 	}
 
-	res := make([]imageResponseModel, len(*li))
-	for i, img := range *li {
-		res[i] = imageResponseModel{
+	if !out.MaxUpdatedAt.IsZero() {
+		etag := fmt.Sprintf(`"%d"`, out.MaxUpdatedAt.UnixNano())
+		c.Response().Header().Set("ETag", etag)
+		if c.Request().Header.Get("If-None-Match") == etag {
+			return c.NoContent(http.StatusNotModified)
+		}
+	}
+
+	res := listImagesResponse{
+		Images:     make([]imageResponseModel, len(out.Images)),
+		NextCursor: encodeListImagesCursor(out.NextCursorCreatedAt, out.NextCursorID),
+	}
+	for i, img := range out.Images {
+		tagsRes := make([]tagResponseModel, len(img.Tags))
+		for j, tag := range img.Tags {
+			tagsRes[j] = tagResponseModel{
+				ID:   tag.ID,
+				Name: tag.Name,
+			}
+		}
+		res.Images[i] = imageResponseModel{
 			ID:          img.ID,
 			ImagePath:   img.ImagePath,
 			DisplayFlag: img.DisplayFlag,
+			Tags:        tagsRes,
 		}
 	}
 
@@ -75,7 +220,7 @@ func (ih *imageHandler) GetImage(c echo.Context) error {
 		return err
 	}
 
-	gi, err := ih.getImageUseCase.Exec(c, id)
+	gi, err := ih.getImageUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
@@ -95,7 +240,7 @@ func (ih *imageHandler) DeleteImage(c echo.Context) error {
 		return err
 	}
 
-	err = ih.deleteImageUseCase.Exec(c, id)
+	err = ih.deleteImageUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
@@ -122,8 +267,20 @@ func (ih *imageHandler) RegisterImage(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to read file"})
 	}
 
-	// key生成
-	key := uuid.New().String()
+	ctx := c.Request().Context()
+
+	// コンテンツアドレス方式のキー(SHA-256)を算出し、同一内容の重複アップロードを避ける
+	digest := fmt.Sprintf("%x", sha256.Sum256(buf.Bytes()))
+
+	if existing, found, err := ih.findImageByDigestUseCase.Exec(ctx, digest); err != nil {
+		return err
+	} else if found {
+		uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, existing.ImagePath)
+		return c.JSON(http.StatusOK, map[string]string{
+			"path":         existing.ImagePath,
+			"uploadedPath": uploadedPath,
+		})
+	}
 
 	// Content-Type を推測
 	contentType := file.Header.Get("Content-Type")
@@ -132,9 +289,9 @@ func (ih *imageHandler) RegisterImage(c echo.Context) error {
 	}
 
 	// S3 にアップロード
-	_, err = ih.awsClient.PutObject(&s3.PutObjectInput{
+	_, err = ih.awsClient.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(ih.ev.AwsS3BucketName),
-		Key:         aws.String(key),
+		Key:         aws.String(digest),
 		Body:        bytes.NewReader(buf.Bytes()),
 		ContentType: aws.String(contentType),
 	})
@@ -143,14 +300,19 @@ func (ih *imageHandler) RegisterImage(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to upload to S3"})
 	}
 
+	// DB登録前にクライアントが切断する等した場合に、孤立したS3オブジェクトを残さないためのクリーンアップ
+	committed := false
+	defer ih.cleanUpOrphanObject(digest, &committed)
+
 	// アップロードしたパス
-	uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, key)
+	uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, digest)
 
 	// DBに保存
-	path, err := ih.registerImageUseCase.Exec(c, key)
+	path, err := ih.registerImageUseCase.Exec(ctx, digest, digest, nil)
 	if err != nil {
 		return err
 	}
+	committed = true
 
 	return c.JSON(http.StatusOK, map[string]string{
 		"path":         path,
@@ -158,6 +320,40 @@ func (ih *imageHandler) RegisterImage(c echo.Context) error {
 	})
 }
 
+// cleanUpOrphanObject deletes the S3 object at digest unless *committed is
+// true, so a request that uploaded a blob but failed (or whose client
+// disconnected) before the Image row was persisted doesn't leave an orphan
+// blob behind. Because the object key is the content digest, two concurrent
+// uploads of identical bytes race to PutObject the same key; the loser's
+// registerImageUseCase.Exec fails on the Digest unique index while the
+// winner's Image row now points at that same object, so cleanUpOrphanObject
+// re-checks findImageByDigestUseCase for a winner before deleting and backs
+// off if one committed in the meantime. The delete runs against a fresh,
+// uncancelled context since the request's own context may already be done.
+func (ih *imageHandler) cleanUpOrphanObject(digest string, committed *bool) {
+	if *committed {
+		return
+	}
+
+	ctx := context.Background()
+
+	if _, found, err := ih.findImageByDigestUseCase.Exec(ctx, digest); err != nil {
+		log.Printf("failed to check for a winning upload before cleaning up S3 object %s: %v\n", digest, err)
+		return
+	} else if found {
+		// A concurrent upload of the same content committed its Image row
+		// first; the object is no longer orphaned.
+		return
+	}
+
+	if _, err := ih.awsClient.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(ih.ev.AwsS3BucketName),
+		Key:    aws.String(digest),
+	}); err != nil {
+		log.Printf("failed to clean up orphaned S3 object %s: %v\n", digest, err)
+	}
+}
+
 func (ih *imageHandler) RegisterImages(c echo.Context) error {
 	form, err := c.MultipartForm()
 	if err != nil {
@@ -171,6 +367,7 @@ func (ih *imageHandler) RegisterImages(c echo.Context) error {
 	}
 
 	uploadedPaths := []map[string]string{}
+	ctx := c.Request().Context()
 
 	for _, file := range files {
 		// ファイルを開く
@@ -186,8 +383,19 @@ func (ih *imageHandler) RegisterImages(c echo.Context) error {
 			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to read file"})
 		}
 
-		// key生成
-		key := uuid.New().String()
+		// コンテンツアドレス方式のキー(SHA-256)を算出し、同一内容の重複アップロードを避ける
+		digest := fmt.Sprintf("%x", sha256.Sum256(buf.Bytes()))
+
+		if existing, found, err := ih.findImageByDigestUseCase.Exec(ctx, digest); err != nil {
+			return err
+		} else if found {
+			uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, existing.ImagePath)
+			uploadedPaths = append(uploadedPaths, map[string]string{
+				"path":         existing.ImagePath,
+				"uploadedPath": uploadedPath,
+			})
+			continue
+		}
 
 		// Content-Type を推測
 		contentType := file.Header.Get("Content-Type")
@@ -196,9 +404,9 @@ func (ih *imageHandler) RegisterImages(c echo.Context) error {
 		}
 
 		// S3 にアップロード
-		_, err = ih.awsClient.PutObject(&s3.PutObjectInput{
+		_, err = ih.awsClient.PutObjectWithContext(ctx, &s3.PutObjectInput{
 			Bucket:      aws.String(ih.ev.AwsS3BucketName),
-			Key:         aws.String(key),
+			Key:         aws.String(digest),
 			Body:        bytes.NewReader(buf.Bytes()),
 			ContentType: aws.String(contentType),
 		})
@@ -208,11 +416,13 @@ func (ih *imageHandler) RegisterImages(c echo.Context) error {
 		}
 
 		// アップロードしたパス
-		uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, key)
+		uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, digest)
 
 		// DBに保存
-		path, err := ih.registerImageUseCase.Exec(c, key)
+		path, err := ih.registerImageUseCase.Exec(ctx, digest, digest, nil)
 		if err != nil {
+			committed := false
+			ih.cleanUpOrphanObject(digest, &committed)
 			return err
 		}
 
@@ -225,3 +435,433 @@ func (ih *imageHandler) RegisterImages(c echo.Context) error {
 	// 全てのファイルのパスをまとめて返す
 	return c.JSON(http.StatusOK, uploadedPaths)
 }
+
+// PresignImageUpload issues a presigned S3 PUT URL, plus a pending Image
+// row for it, so large files can be uploaded directly to S3 instead of
+// being proxied through this server.
+func (ih *imageHandler) PresignImageUpload(c echo.Context) error {
+	var req presignImageUploadRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageApp.RequestImageUploadInputDto{
+		ContentType: req.ContentType,
+	}
+
+	out, err := ih.requestImageUploadUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, presignImageUploadResponse{
+		ImageID:         out.ImageID,
+		UploadURL:       out.UploadURL,
+		ObjectKey:       out.ObjectKey,
+		RequiredHeaders: out.RequiredHeaders,
+	})
+}
+
+// ConfirmImageUpload verifies a presigned upload landed in S3 and persists
+// the corresponding Image row.
+func (ih *imageHandler) ConfirmImageUpload(c echo.Context) error {
+	var req confirmImageUploadRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageApp.ConfirmImageUploadInputDto{
+		ObjectKey: req.ObjectKey,
+	}
+
+	img, err := ih.confirmImageUploadUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	res := imageResponseModel{
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
+		DisplayFlag: img.DisplayFlag,
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// SearchImages lists images filtered by tag set semantics (match=all|any|none),
+// paginated by a keyset cursor on image id.
+func (ih *imageHandler) SearchImages(c echo.Context) error {
+	var req searchImagesRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	match := req.Match
+	if match == "" {
+		match = "any"
+	}
+
+	input := imageApp.SearchImagesInputDto{
+		TagIDs: req.TagIDs,
+		Match:  match,
+		Limit:  req.Limit,
+		Cursor: req.Cursor,
+	}
+
+	out, err := ih.searchImagesUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	res := searchImagesResponse{
+		Images:     make([]imageResponseModel, len(out.Images)),
+		TotalCount: out.TotalCount,
+		NextCursor: out.NextCursor,
+	}
+	for i, img := range out.Images {
+		tagsRes := make([]tagResponseModel, len(img.Tags))
+		for j, tag := range img.Tags {
+			tagsRes[j] = tagResponseModel{
+				ID:   tag.ID,
+				Name: tag.Name,
+			}
+		}
+		res.Images[i] = imageResponseModel{
+			ID:          img.ID,
+			ImagePath:   img.ImagePath,
+			DisplayFlag: img.DisplayFlag,
+			Tags:        tagsRes,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// ReplaceImageTags sets the tags on an image to exactly the given tag_ids,
+// inserting what's missing and removing what's no longer wanted atomically.
+func (ih *imageHandler) ReplaceImageTags(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	var req replaceImageTagsRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageTagApp.ReplaceImageTagsInputDto{
+		ImageID: id,
+		TagIDs:  req.TagIDs,
+	}
+
+	if err := ih.replaceImageTagsUseCase.Exec(c.Request().Context(), input); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// TagImage assigns a human-readable (name, tag) reference to an image,
+// re-pointing it if the reference already exists (mirrors `docker tag`).
+func (ih *imageHandler) TagImage(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	var req tagImageRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageReferenceApp.TagImageInputDto{
+		ImageID: id,
+		Name:    req.Name,
+		Tag:     req.Tag,
+	}
+
+	if err := ih.tagImageUseCase.Exec(c.Request().Context(), input); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// UntagImage removes a (name, tag) reference without deleting the underlying image.
+func (ih *imageHandler) UntagImage(c echo.Context) error {
+	input := imageReferenceApp.UntagImageInputDto{
+		Name: c.Param("name"),
+		Tag:  c.Param("tag"),
+	}
+
+	if err := ih.untagImageUseCase.Exec(c.Request().Context(), input); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// ResolveReference looks up an image by its (name, tag) reference instead of numeric id.
+func (ih *imageHandler) ResolveReference(c echo.Context) error {
+	input := imageReferenceApp.ResolveReferenceInputDto{
+		Name: c.Param("name"),
+		Tag:  c.Param("tag"),
+	}
+
+	img, err := ih.resolveReferenceUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	res := imageResponseModel{
+		ID:          img.ID,
+		ImagePath:   img.ImagePath,
+		DisplayFlag: img.DisplayFlag,
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// importBundleManifestName is the fixed path of the manifest file inside an
+// import/export tar, analogous to `docker save`'s manifest.json.
+const importBundleManifestName = "manifest.json"
+
+// ImportImages restores images + tag associations + references from a tar
+// stream shaped like manifest.json plus blobs/sha256/<digest>, as produced
+// by ExportImages. Blobs already present in S3 (by digest) are skipped.
+func (ih *imageHandler) ImportImages(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	var manifest imageBundleDomain.Manifest
+	tr := tar.NewReader(c.Request().Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid tar stream"})
+		}
+
+		switch {
+		case hdr.Name == importBundleManifestName:
+			if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid manifest.json"})
+			}
+		case strings.HasPrefix(hdr.Name, "blobs/sha256/"):
+			digest := strings.TrimPrefix(hdr.Name, "blobs/sha256/")
+
+			if _, err := ih.awsClient.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+				Bucket: aws.String(ih.ev.AwsS3BucketName),
+				Key:    aws.String(digest),
+			}); err == nil {
+				continue // 既に同一ダイジェストのオブジェクトが存在するためアップロードをスキップ
+			} else if aerr, ok := err.(awserr.Error); !ok || (aerr.Code() != s3.ErrCodeNoSuchKey && aerr.Code() != "NotFound") {
+				return err
+			}
+
+			buf := bytes.NewBuffer(nil)
+			if _, err := buf.ReadFrom(tr); err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "failed to read blob"})
+			}
+
+			if _, err := ih.awsClient.PutObjectWithContext(ctx, &s3.PutObjectInput{
+				Bucket: aws.String(ih.ev.AwsS3BucketName),
+				Key:    aws.String(digest),
+				Body:   bytes.NewReader(buf.Bytes()),
+			}); err != nil {
+				log.Printf("failed to upload blob to S3: %v\n", err)
+				return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to upload to S3"})
+			}
+		}
+	}
+
+	if err := ih.importImagesUseCase.Exec(ctx, imageBundleApp.ImportImagesInputDto{Entries: manifest}); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// ExportImages streams every image matching the ?tag / ?ref filters back as
+// a tar bundle (manifest.json plus blobs/sha256/<digest>) for backup or
+// migration to another environment.
+func (ih *imageHandler) ExportImages(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	input := imageBundleApp.ExportImagesInputDto{
+		TagNames: c.QueryParams()["tag"],
+	}
+
+	for _, rawRef := range c.QueryParams()["ref"] {
+		idx := strings.LastIndex(rawRef, ":")
+		if idx < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "invalid ref: " + rawRef})
+		}
+
+		img, err := ih.resolveReferenceUseCase.Exec(ctx, imageReferenceApp.ResolveReferenceInputDto{
+			Name: rawRef[:idx],
+			Tag:  rawRef[idx+1:],
+		})
+		if err != nil {
+			return err
+		}
+		input.ImageIDs = append(input.ImageIDs, img.ID)
+	}
+
+	out, err := ih.exportImagesUseCase.Exec(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "application/x-tar")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="images-export.tar"`)
+	c.Response().WriteHeader(http.StatusOK)
+
+	tw := tar.NewWriter(c.Response())
+	defer tw.Close()
+
+	manifestBytes, err := json.Marshal(out.Manifest)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: importBundleManifestName, Mode: 0o644, Size: int64(len(manifestBytes))}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(manifestBytes); err != nil {
+		return err
+	}
+
+	for _, entry := range out.Manifest {
+		obj, err := ih.awsClient.GetObjectWithContext(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(ih.ev.AwsS3BucketName),
+			Key:    aws.String(entry.Digest),
+		})
+		if err != nil {
+			return err
+		}
+
+		blob, err := io.ReadAll(obj.Body)
+		obj.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		if err := tw.WriteHeader(&tar.Header{
+			Name: "blobs/sha256/" + entry.Digest,
+			Mode: 0o644,
+			Size: int64(len(blob)),
+		}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(blob); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetImageHistory returns the derivation chain for an image (itself up to
+// its root ancestor), each step annotated with its own tag set.
+func (ih *imageHandler) GetImageHistory(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	steps, err := ih.getImageHistoryUseCase.Exec(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	res := make([]imageHistoryStepResponse, len(steps))
+	for i, step := range steps {
+		tagsRes := make([]string, len(step.Tags))
+		copy(tagsRes, step.Tags)
+		res[i] = imageHistoryStepResponse{
+			ID:        step.ID,
+			Digest:    step.Digest,
+			ImagePath: step.ImagePath,
+			Tags:      tagsRes,
+			CreatedAt: step.CreatedAt,
+			CreatedBy: step.CreatedBy,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// DeriveImage uploads a new image declaring an existing image (the :id
+// path param) as its parent, e.g. for a crop, re-encode, or watermarked
+// variant.
+func (ih *imageHandler) DeriveImage(c echo.Context) error {
+	parentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return err
+	}
+
+	file, err := c.FormFile("image")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "failed to get file"})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to open file"})
+	}
+	defer src.Close()
+
+	buf := bytes.NewBuffer(nil)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to read file"})
+	}
+
+	ctx := c.Request().Context()
+
+	// コンテンツアドレス方式のキー(SHA-256)を算出し、同一内容の重複アップロードを避ける
+	digest := fmt.Sprintf("%x", sha256.Sum256(buf.Bytes()))
+
+	if existing, found, err := ih.findImageByDigestUseCase.Exec(ctx, digest); err != nil {
+		return err
+	} else if found {
+		uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, existing.ImagePath)
+		return c.JSON(http.StatusOK, map[string]string{
+			"path":         existing.ImagePath,
+			"uploadedPath": uploadedPath,
+		})
+	}
+
+	contentType := file.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	_, err = ih.awsClient.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(ih.ev.AwsS3BucketName),
+		Key:         aws.String(digest),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		log.Printf("failed to upload file to S3: %v\n", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "failed to upload to S3"})
+	}
+
+	committed := false
+	defer ih.cleanUpOrphanObject(digest, &committed)
+
+	uploadedPath := fmt.Sprintf("%s/%s/%s", ih.ev.AwsS3EndpointExternal, ih.ev.AwsS3BucketName, digest)
+
+	path, err := ih.registerImageUseCase.Exec(ctx, digest, digest, &parentID)
+	if err != nil {
+		return err
+	}
+	committed = true
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"path":         path,
+		"uploadedPath": uploadedPath,
+	})
+}
@@ -1,5 +1,39 @@
 package image
 
+type listImagesRequest struct {
+	Limit          int    `query:"limit" example:"20" ja:"取得件数"`
+	Cursor         string `query:"cursor" example:"2024-01-02T15:04:05.000000001Z_42" ja:"前回レスポンスのnext_cursor"`
+	DisplayFlag    *bool  `query:"display_flag" example:"true" ja:"表示フラグでの絞り込み"`
+	CreatedAfter   string `query:"created_after" example:"2024-01-01T00:00:00Z" ja:"作成日時の下限(RFC3339)"`
+	CreatedBefore  string `query:"created_before" example:"2024-12-31T23:59:59Z" ja:"作成日時の上限(RFC3339)"`
+	Q              string `query:"q" example:"cat" ja:"image_pathの部分一致検索"`
+	IncludeDeleted bool   `query:"include_deleted" example:"false" ja:"ソフトデリート済み画像も含めるか"`
+}
+
 type getUntaggedImagesByTagsRequest struct {
 	TagIDs []int `json:"tag_ids" example:"[10, 20, 30]" ja:"タグIDのリスト" validate:"required,min=1"`
 }
+
+type presignImageUploadRequest struct {
+	ContentType string `json:"content_type" example:"image/png" ja:"アップロードするファイルのContent-Type" validate:"required"`
+}
+
+type confirmImageUploadRequest struct {
+	ObjectKey string `json:"object_key" example:"3fa9c1d2-..." ja:"presignで払い出したオブジェクトキー" validate:"required"`
+}
+
+type replaceImageTagsRequest struct {
+	TagIDs []int `json:"tag_ids" example:"[10, 20, 30]" ja:"画像に設定するタグIDのリスト" validate:"required"`
+}
+
+type tagImageRequest struct {
+	Name string `json:"name" example:"my-app" ja:"参照名(docker tagのrepository相当)" validate:"required"`
+	Tag  string `json:"tag" example:"latest" ja:"参照タグ" validate:"required"`
+}
+
+type searchImagesRequest struct {
+	TagIDs []int  `query:"tag_ids" example:"[10, 20, 30]" ja:"タグIDのリスト" validate:"required,min=1"`
+	Match  string `query:"match" example:"all" ja:"タグの一致条件(all/any/none)" validate:"omitempty,oneof=all any none"`
+	Limit  int    `query:"limit" example:"20" ja:"取得件数"`
+	Cursor int    `query:"cursor" example:"0" ja:"前回レスポンスのnext_cursor"`
+}
@@ -1,5 +1,10 @@
 package image
 
+import (
+	"net/http"
+	"time"
+)
+
 type getImageResponse struct {
 	Image imageResponseModel `json:"images"`
 }
@@ -15,3 +20,32 @@ type tagResponseModel struct {
 	ID   int    `json:"id"`
 	Name string `json:"name"`
 }
+
+type presignImageUploadResponse struct {
+	ImageID         int         `json:"image_id"`
+	UploadURL       string      `json:"upload_url"`
+	ObjectKey       string      `json:"object_key"`
+	RequiredHeaders http.Header `json:"required_headers"`
+}
+
+type searchImagesResponse struct {
+	Images     []imageResponseModel `json:"images"`
+	TotalCount int64                `json:"total_count"`
+	NextCursor int                  `json:"next_cursor"`
+}
+
+type listImagesResponse struct {
+	Images []imageResponseModel `json:"images"`
+	// NextCursor is opaque; pass it back verbatim as ?cursor= to fetch the
+	// next page. Empty when there are no more rows.
+	NextCursor string `json:"next_cursor"`
+}
+
+type imageHistoryStepResponse struct {
+	ID        int       `json:"id"`
+	Digest    string    `json:"digest"`
+	ImagePath string    `json:"image_path"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
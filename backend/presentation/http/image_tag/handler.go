@@ -4,8 +4,8 @@ import (
 	"net/http"
 	"strconv"
 
-	imageTagApp "github.com/keito-isurugi/various-app/application/image_tag"
-	"github.com/keito-isurugi/various-app/infrastructure/env"
+	imageTagApp "github.com/keito-isurugi/kei-talk/application/image_tag"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
 	"github.com/labstack/echo/v4"
 )
 
@@ -16,6 +16,8 @@ type ImageTagHandler interface {
 	RegisterImageTag(c echo.Context) error
 	RegisterMultipleImageTags(c echo.Context) error
 	DeleteMultipleImageTags(c echo.Context) error
+	UntagImage(c echo.Context) error
+	UntagMultipleImages(c echo.Context) error
 }
 
 type imageTagHandler struct {
@@ -26,6 +28,8 @@ type imageTagHandler struct {
 	registerImageTagUseCase         imageTagApp.RegisterImageTagUseCase
 	registerMultipleImageTagUseCase imageTagApp.RegisterMultipleImageTagsUseCase
 	deleteMultipleImageTagUseCase   imageTagApp.DeleteMultipleImageTagsUseCase
+	untagImageUseCase               imageTagApp.UntagImageUseCase
+	untagMultipleImagesUseCase      imageTagApp.UntagMultipleImagesUseCase
 }
 
 func NewImageTagHandler(
@@ -36,6 +40,8 @@ func NewImageTagHandler(
 	deleteImageTagUseCase imageTagApp.DeleteImageTagUseCase,
 	registerMultipleImageTagUseCase imageTagApp.RegisterMultipleImageTagsUseCase,
 	deleteMultipleImageTagUseCase imageTagApp.DeleteMultipleImageTagsUseCase,
+	untagImageUseCase imageTagApp.UntagImageUseCase,
+	untagMultipleImagesUseCase imageTagApp.UntagMultipleImagesUseCase,
 ) ImageTagHandler {
 	return &imageTagHandler{
 		ev:                              ev,
@@ -45,11 +51,13 @@ func NewImageTagHandler(
 		deleteImageTagUseCase:           deleteImageTagUseCase,
 		registerMultipleImageTagUseCase: registerMultipleImageTagUseCase,
 		deleteMultipleImageTagUseCase:   deleteMultipleImageTagUseCase,
+		untagImageUseCase:               untagImageUseCase,
+		untagMultipleImagesUseCase:      untagMultipleImagesUseCase,
 	}
 }
 
 func (h *imageTagHandler) ListImageTags(c echo.Context) error {
-	lit, err := h.listImageTagsUseCase.Exec(c)
+	lit, err := h.listImageTagsUseCase.Exec(c.Request().Context())
 	if err != nil {
 		return err
 	}
@@ -72,7 +80,7 @@ func (h *imageTagHandler) GetImageTag(c echo.Context) error {
 		return err
 	}
 
-	it, err := h.getImageTagUseCase.Exec(c, id)
+	it, err := h.getImageTagUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
@@ -92,7 +100,7 @@ func (h *imageTagHandler) DeleteImageTag(c echo.Context) error {
 		return err
 	}
 
-	err = h.deleteImageTagUseCase.Exec(c, id)
+	err = h.deleteImageTagUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
@@ -111,7 +119,7 @@ func (h *imageTagHandler) RegisterImageTag(c echo.Context) error {
 		TagID:   req.TagID,
 	}
 
-	id, err := h.registerImageTagUseCase.Exec(c, input)
+	id, err := h.registerImageTagUseCase.Exec(c.Request().Context(), input)
 	if err != nil {
 		return err
 	}
@@ -130,7 +138,7 @@ func (h *imageTagHandler) RegisterMultipleImageTags(c echo.Context) error {
 		TagIDs:   req.TagIDs,
 	}
 
-	ids, err := h.registerMultipleImageTagUseCase.Exec(c, input)
+	ids, err := h.registerMultipleImageTagUseCase.Exec(c.Request().Context(), input)
 	if err != nil {
 		return err
 	}
@@ -149,10 +157,61 @@ func (h *imageTagHandler) DeleteMultipleImageTags(c echo.Context) error {
 		TagIDs:   req.TagIDs,
 	}
 
-	err := h.deleteMultipleImageTagUseCase.Exec(c, input)
+	err := h.deleteMultipleImageTagUseCase.Exec(c.Request().Context(), input)
 	if err != nil {
 		return err
 	}
 
 	return c.JSON(http.StatusOK, nil)
 }
+
+func (h *imageTagHandler) UntagImage(c echo.Context) error {
+	var req untagImageRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageTagApp.UntagImageInputDto{
+		ImageID: req.ImageID,
+		TagName: req.TagName,
+	}
+
+	result, err := h.untagImageUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, untagPairResponse{
+		ImageID: result.ImageID,
+		TagName: result.TagName,
+		Removed: result.Removed,
+	})
+}
+
+func (h *imageTagHandler) UntagMultipleImages(c echo.Context) error {
+	var req untagMultipleImagesRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+
+	input := imageTagApp.UntagMultipleImagesInputDto{
+		ImageIDs: req.ImageIDs,
+		TagNames: req.TagNames,
+	}
+
+	results, err := h.untagMultipleImagesUseCase.Exec(c.Request().Context(), input)
+	if err != nil {
+		return err
+	}
+
+	res := make([]untagPairResponse, len(results))
+	for i, r := range results {
+		res[i] = untagPairResponse{
+			ImageID: r.ImageID,
+			TagName: r.TagName,
+			Removed: r.Removed,
+		}
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
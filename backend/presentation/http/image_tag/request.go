@@ -9,3 +9,13 @@ type updateMultipleImageTagsRequest struct {
 	ImageIDs []int `json:"image_ids" example:"[1, 2, 3]" ja:"画像IDのリスト" validate:"required,min=1"`
 	TagIDs   []int `json:"tag_ids" example:"[10, 20, 30]" ja:"タグIDのリスト" validate:"required,min=1"`
 }
+
+type untagImageRequest struct {
+	ImageID int    `json:"image_id" example:"1" ja:"画像ID" validate:"required"`
+	TagName string `json:"tag_name" example:"landscape" ja:"タグ名" validate:"required"`
+}
+
+type untagMultipleImagesRequest struct {
+	ImageIDs []int    `json:"image_ids" example:"[1, 2, 3]" ja:"画像IDのリスト" validate:"required,min=1"`
+	TagNames []string `json:"tag_names" example:"[\"landscape\", \"portrait\"]" ja:"タグ名のリスト" validate:"required,min=1"`
+}
@@ -9,3 +9,11 @@ type imageTagResponseModel struct {
 	ImageID int `json:"image_id"`
 	TagID   int `json:"tag_id"`
 }
+
+// untagPairResponse reports whether the (image, tag) join row was actually
+// removed, so a client can distinguish an idempotent no-op from an error.
+type untagPairResponse struct {
+	ImageID int    `json:"image_id"`
+	TagName string `json:"tag_name"`
+	Removed bool   `json:"removed"`
+}
@@ -1,11 +1,11 @@
 package tag
 
 import (
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+	"github.com/keito-isurugi/kei-talk/infrastructure/env"
+	"github.com/labstack/echo/v4"
 	"net/http"
 	"strconv"
-	tagApp "github.com/keito-isurugi/various-app/application/tag"
-	"github.com/keito-isurugi/various-app/infrastructure/env"
-	"github.com/labstack/echo/v4"
 )
 
 type ImageHandler interface {
@@ -17,11 +17,11 @@ type ImageHandler interface {
 }
 
 type tagHandler struct {
-	ev                   *env.Values
+	ev                 *env.Values
 	listTagsUseCase    tagApp.ListTagsUseCase
 	getTagUseCase      tagApp.GetTagUseCase
 	registerTagUseCase tagApp.RegisterTagUseCase
-	updateTagUseCase tagApp.UpdateTagUseCase
+	updateTagUseCase   tagApp.UpdateTagUseCase
 	deleteTagUseCase   tagApp.DeleteTagUseCase
 }
 
@@ -34,17 +34,17 @@ func NewImageHandler(
 	deleteTagUseCase tagApp.DeleteTagUseCase,
 ) ImageHandler {
 	return &tagHandler{
-		ev:                   ev,
+		ev:                 ev,
 		listTagsUseCase:    listTagsUseCase,
 		getTagUseCase:      getTagUseCase,
 		registerTagUseCase: registerTagUseCase,
-		updateTagUseCase: updateTagUseCase,
+		updateTagUseCase:   updateTagUseCase,
 		deleteTagUseCase:   deleteTagUseCase,
 	}
 }
 
 func (h *tagHandler) ListTags(c echo.Context) error {
-	lt, err := h.listTagsUseCase.Exec(c)
+	lt, err := h.listTagsUseCase.Exec(c.Request().Context())
 	if err != nil {
 		return err
 	}
@@ -52,8 +52,8 @@ func (h *tagHandler) ListTags(c echo.Context) error {
 	res := make([]tagResponseModel, len(*lt))
 	for i, tag := range *lt {
 		res[i] = tagResponseModel{
-			ID:          tag.ID,
-			Name:   tag.Name,
+			ID:   tag.ID,
+			Name: tag.Name,
 		}
 	}
 
@@ -66,14 +66,14 @@ func (h *tagHandler) GetTag(c echo.Context) error {
 		return err
 	}
 
-	tag, err := h.getTagUseCase.Exec(c, id)
+	tag, err := h.getTagUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
 	res := tagResponseModel{
-		ID:          tag.ID,
-		Name:   tag.Name,
+		ID:   tag.ID,
+		Name: tag.Name,
 	}
 
 	return c.JSON(http.StatusOK, res)
@@ -85,7 +85,7 @@ func (h *tagHandler) RegisterTag(c echo.Context) error {
 		return err
 	}
 
-	id, err := h.registerTagUseCase.Exec(c, req.Name)
+	id, err := h.registerTagUseCase.Exec(c.Request().Context(), req.Name)
 	if err != nil {
 		return err
 	}
@@ -100,11 +100,11 @@ func (h *tagHandler) UpdateTag(c echo.Context) error {
 	}
 
 	input := tagApp.TagUseCaseInputDto{
-		ID: req.ID,
+		ID:   req.ID,
 		Name: req.Name,
 	}
 
-	err := h.updateTagUseCase.Exec(c, input)
+	err := h.updateTagUseCase.Exec(c.Request().Context(), input)
 	if err != nil {
 		return err
 	}
@@ -118,7 +118,7 @@ func (h *tagHandler) DeleteTag(c echo.Context) error {
 		return err
 	}
 
-	err = h.deleteTagUseCase.Exec(c, id)
+	err = h.deleteTagUseCase.Exec(c.Request().Context(), id)
 	if err != nil {
 		return err
 	}
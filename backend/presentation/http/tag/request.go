@@ -1,10 +1,10 @@
 package tag
 
 type registerTagRequest struct {
-	Name string `json:"name" example:"タグ名" ja:"タグ名" validate:"required,max=255"`
+	Name string `json:"name" example:"character/mario:classic" ja:"タグ名(namespace/name:variant形式)" validate:"required,max=255"`
 }
 
 type updateTagRequest struct {
-	ID    int    `param:"id" example:"1" ja:"タグID" validate:"required"`
-	Name string `json:"name" example:"タグ名" ja:"タグ名" validate:"required,max=255"`
+	ID   int    `param:"id" example:"1" ja:"タグID" validate:"required"`
+	Name string `json:"name" example:"character/mario:classic" ja:"タグ名(namespace/name:variant形式)" validate:"required,max=255"`
 }
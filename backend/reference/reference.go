@@ -0,0 +1,55 @@
+// Package reference implements a minimal, OCI-inspired grammar for image
+// names and tags, mirroring the name/tag split used by
+// github.com/distribution/reference without pulling in the full dependency.
+package reference
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const nameComponentPattern = `[a-z0-9]+(?:[._-][a-z0-9]+)*`
+
+var (
+	nameRegexp = regexp.MustCompile(`^` + nameComponentPattern + `(?:/` + nameComponentPattern + `)*$`)
+	tagRegexp  = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+)
+
+// Reference is a parsed, validated (name, tag) pair identifying an image,
+// e.g. "team/cat-photos:v2".
+type Reference struct {
+	Name string
+	Tag  string
+}
+
+// Parse validates name and tag against the reference grammar and returns the
+// resulting Reference. name must be lowercase alphanumerics separated by
+// '.', '_', '-' or '/'; tag must start with an alphanumeric or underscore
+// and be at most 128 characters.
+func Parse(name, tag string) (*Reference, error) {
+	if !nameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid reference name: %s", name)
+	}
+	if !tagRegexp.MatchString(tag) {
+		return nil, fmt.Errorf("invalid reference tag: %s", tag)
+	}
+
+	return &Reference{Name: name, Tag: tag}, nil
+}
+
+func (r *Reference) String() string {
+	return r.Name + ":" + r.Tag
+}
+
+// ParseString splits s on its last ':' into a name and tag and validates
+// them via Parse, accepting the "name:tag" form used by manifest.json
+// entries in the bulk import/export tar format.
+func ParseString(s string) (*Reference, error) {
+	idx := strings.LastIndex(s, ":")
+	if idx < 0 {
+		return nil, fmt.Errorf("invalid reference: %s", s)
+	}
+
+	return Parse(s[:idx], s[idx+1:])
+}
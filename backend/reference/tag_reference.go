@@ -0,0 +1,75 @@
+package reference
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// variantPattern is deliberately looser than nameComponentPattern: a tag
+// variant (e.g. "v2", "Classic_Edition") doesn't double as a URL path
+// segment the way an image reference name does, so mixed case is fine.
+var variantPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,128}$`)
+
+// reservedTagNames can't be used as a TagReference's local name: each one
+// already means something else as a SearchImagesQuery.Match value, so a tag
+// named e.g. "any" would be ambiguous wherever both meanings are in play.
+var reservedTagNames = map[string]bool{
+	"all":  true,
+	"any":  true,
+	"none": true,
+}
+
+// TagReference is a parsed, validated tag name in canonical
+// "namespace/name:variant" form, e.g. "character/mario:classic". Namespace
+// and Variant are both optional.
+type TagReference struct {
+	Namespace string
+	Name      string
+	Variant   string
+}
+
+// ParseTag validates s against the namespace/name:variant grammar and
+// returns the resulting TagReference. The namespace/name portion follows
+// the same lowercase-alnum-plus-separators grammar as image references
+// (see nameRegexp); variant, if present, must match variantPattern.
+func ParseTag(s string) (*TagReference, error) {
+	name := s
+	variant := ""
+	if idx := strings.LastIndex(s, ":"); idx >= 0 {
+		name = s[:idx]
+		variant = s[idx+1:]
+	}
+
+	if !nameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("invalid tag name: %s", name)
+	}
+	if variant != "" && !variantPattern.MatchString(variant) {
+		return nil, fmt.Errorf("invalid tag variant: %s", variant)
+	}
+
+	namespace := ""
+	localName := name
+	if idx := strings.LastIndex(name, "/"); idx >= 0 {
+		namespace = name[:idx]
+		localName = name[idx+1:]
+	}
+
+	if reservedTagNames[localName] {
+		return nil, fmt.Errorf("tag name %q is reserved", localName)
+	}
+
+	return &TagReference{Namespace: namespace, Name: localName, Variant: variant}, nil
+}
+
+// String renders the canonical namespace/name:variant form.
+func (r *TagReference) String() string {
+	full := r.Name
+	if r.Namespace != "" {
+		full = r.Namespace + "/" + r.Name
+	}
+	if r.Variant != "" {
+		full += ":" + r.Variant
+	}
+	return full
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"google.golang.org/grpc"
+
+	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	imageTagApp "github.com/keito-isurugi/kei-talk/application/image_tag"
+	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
+	grpcServer "github.com/keito-isurugi/kei-talk/infrastructure/grpc"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
+	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql/repository"
+)
+
+// SetupGRPCServer wires the same use cases as imageRouter, tagRouter, and
+// imageTagRouter into the gRPC Images, Tags, and ImageTags services defined
+// in api/v1/various_app.proto.
+func SetupGRPCServer(dbClient db.Client) *grpc.Server {
+	imageRepo := repository.NewImageRepository(dbClient)
+	tagRepo := repository.NewTagRepository(dbClient)
+	imageTagRepo := repository.NewImageTagRepository(dbClient)
+
+	imagesSrv := grpcServer.NewImagesServer(
+		imageApp.NewListImagesUseCase(imageRepo),
+		imageApp.NewGetImageUseCase(imageRepo),
+		imageApp.NewDeleteImageUseCase(imageRepo),
+		imageApp.NewRegisterImageUseCase(imageRepo),
+	)
+
+	tagsSrv := grpcServer.NewTagsServer(
+		tagApp.NewListTagsUseCase(tagRepo),
+		tagApp.NewGetTagUseCase(tagRepo),
+		tagApp.NewRegisterTagUseCase(tagRepo),
+		tagApp.NewUpdateTagUseCase(tagRepo),
+		tagApp.NewDeleteTagUseCase(tagRepo),
+	)
+
+	imageTagsSrv := grpcServer.NewImageTagsServer(
+		imageTagApp.NewListImageTagsUseCase(imageTagRepo),
+		imageTagApp.NewGetImageTagUseCase(imageTagRepo),
+		imageTagApp.NewRegisterImageTagUseCase(imageTagRepo),
+		imageTagApp.NewDeleteImageTagUseCase(imageTagRepo),
+		imageTagApp.NewRegisterMultipleImageTagsUseCase(imageTagRepo),
+		imageTagApp.NewDeleteMultipleImageTagsUseCase(imageTagRepo),
+	)
+
+	return grpcServer.NewServer(imagesSrv, tagsSrv, imageTagsSrv)
+}
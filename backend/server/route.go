@@ -2,14 +2,18 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	imageApp "github.com/keito-isurugi/kei-talk/application/image"
+	imageBundleApp "github.com/keito-isurugi/kei-talk/application/image_bundle"
+	imageReferenceApp "github.com/keito-isurugi/kei-talk/application/image_reference"
 	imageTagApp "github.com/keito-isurugi/kei-talk/application/image_tag"
 	tagApp "github.com/keito-isurugi/kei-talk/application/tag"
 	"github.com/keito-isurugi/kei-talk/infrastructure/env"
 	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql"
 	"github.com/keito-isurugi/kei-talk/infrastructure/postgresql/repository"
+	s3Repo "github.com/keito-isurugi/kei-talk/infrastructure/s3"
 	imagePre "github.com/keito-isurugi/kei-talk/presentation/http/image"
 	imageTagPre "github.com/keito-isurugi/kei-talk/presentation/http/image_tag"
 	tagPre "github.com/keito-isurugi/kei-talk/presentation/http/tag"
@@ -24,6 +28,11 @@ func SetupRouter(ev *env.Values, dbClient db.Client, _ *zap.Logger, awsClient s3
 		AllowOrigins: []string{"*"},
 		AllowHeaders: []string{"*"},
 	}))
+	// リクエストごとにタイムアウト付きcontextを割り当て、クライアント切断後も
+	// use case層の処理が残り続けないようにする。
+	e.Use(echoMiddleware.TimeoutWithConfig(echoMiddleware.TimeoutConfig{
+		Timeout: time.Duration(ev.Server.RequestTimeoutSeconds) * time.Second,
+	}))
 
 	api := e.Group("/api")
 	api.GET("/health", func(c echo.Context) error {
@@ -39,6 +48,10 @@ func SetupRouter(ev *env.Values, dbClient db.Client, _ *zap.Logger, awsClient s3
 
 func imageRouter(ev *env.Values, awsClient s3iface.S3API, eg *echo.Group, dbClient db.Client) {
 	imageRepo := repository.NewImageRepository(dbClient)
+	imageTagRepo := repository.NewImageTagRepository(dbClient)
+	imageReferenceRepo := repository.NewImageReferenceRepository(dbClient)
+	imageBundleRepo := repository.NewImageBundleRepository(dbClient)
+	storageRepo := s3Repo.NewS3Repository(ev, awsClient)
 	h := imagePre.NewImageHandler(
 		ev,
 		awsClient,
@@ -46,14 +59,36 @@ func imageRouter(ev *env.Values, awsClient s3iface.S3API, eg *echo.Group, dbClie
 		imageApp.NewGetImageUseCase(imageRepo),
 		imageApp.NewDeleteImageUseCase(imageRepo),
 		imageApp.NewRegisterImageUseCase(imageRepo),
+		imageApp.NewFindImageByDigestUseCase(imageRepo),
+		imageApp.NewRequestImageUploadUseCase(ev, storageRepo, imageRepo),
+		imageApp.NewConfirmImageUploadUseCase(ev, storageRepo, imageRepo),
+		imageApp.NewSearchImagesUseCase(imageRepo),
+		imageTagApp.NewReplaceImageTagsUseCase(imageTagRepo),
+		imageReferenceApp.NewTagImageUseCase(imageReferenceRepo),
+		imageReferenceApp.NewUntagImageUseCase(imageReferenceRepo),
+		imageReferenceApp.NewResolveReferenceUseCase(imageReferenceRepo, imageRepo),
+		imageBundleApp.NewImportImagesUseCase(imageBundleRepo),
+		imageBundleApp.NewExportImagesUseCase(imageBundleRepo, imageReferenceRepo),
+		imageApp.NewGetImageHistoryUseCase(imageRepo),
 	)
 
 	imageGroup := eg.Group("/images")
 	imageGroup.GET("", h.ListImages)
+	imageGroup.GET("/search", h.SearchImages)
+	imageGroup.GET("/export", h.ExportImages)
+	imageGroup.GET("/by-ref/:name/:tag", h.ResolveReference)
+	imageGroup.GET("/:id/history", h.GetImageHistory)
 	imageGroup.GET("/:id", h.GetImage)
 	imageGroup.DELETE("/:id", h.DeleteImage)
 	imageGroup.PUT("", h.RegisterImage)
 	imageGroup.PUT("/multi", h.RegisterImages)
+	imageGroup.PUT("/:id/tags", h.ReplaceImageTags)
+	imageGroup.POST("/:id/tags", h.TagImage)
+	imageGroup.POST("/:id/derive", h.DeriveImage)
+	imageGroup.DELETE("/tags/:name/:tag", h.UntagImage)
+	imageGroup.POST("/presign", h.PresignImageUpload)
+	imageGroup.POST("/confirm", h.ConfirmImageUpload)
+	imageGroup.POST("/import", h.ImportImages)
 }
 
 func tagRouter(ev *env.Values, eg *echo.Group, dbClient db.Client) {
@@ -77,6 +112,7 @@ func tagRouter(ev *env.Values, eg *echo.Group, dbClient db.Client) {
 
 func imageTagRouter(ev *env.Values, eg *echo.Group, dbClient db.Client) {
 	imageTagRepo := repository.NewImageTagRepository(dbClient)
+	tagRepo := repository.NewTagRepository(dbClient)
 	h := imageTagPre.NewImageTagHandler(
 		ev,
 		imageTagApp.NewListImageTagsUseCase(imageTagRepo),
@@ -85,6 +121,8 @@ func imageTagRouter(ev *env.Values, eg *echo.Group, dbClient db.Client) {
 		imageTagApp.NewDeleteImageTagUseCase(imageTagRepo),
 		imageTagApp.NewRegisterMultipleImageTagsUseCase(imageTagRepo),
 		imageTagApp.NewDeleteMultipleImageTagsUseCase(imageTagRepo),
+		imageTagApp.NewUntagImageUseCase(imageTagRepo, tagRepo),
+		imageTagApp.NewUntagMultipleImagesUseCase(imageTagRepo, tagRepo),
 	)
 
 	imageTagGroup := eg.Group("/image-tags")
@@ -93,4 +131,6 @@ func imageTagRouter(ev *env.Values, eg *echo.Group, dbClient db.Client) {
 	imageTagGroup.DELETE("/:id", h.DeleteImageTag)
 	imageTagGroup.POST("/multi", h.RegisterMultipleImageTags)
 	imageTagGroup.DELETE("/multi", h.DeleteMultipleImageTags)
+	imageTagGroup.DELETE("/untag", h.UntagImage)
+	imageTagGroup.DELETE("/untag/multi", h.UntagMultipleImages)
 }